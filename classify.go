@@ -0,0 +1,163 @@
+package kana
+
+// IsHiragana reports whether r is a hiragana letter or
+// a hiragana iteration mark converted to katakana by [HiraganaToKatakana].
+//
+// The following characters are hiragana:
+//
+//   - U+3041 HIRAGANA LETTER SMALL A (ぁ) to U+3096 HIRAGANA LETTER SMALL KE (ゖ)
+//   - U+309D HIRAGANA ITERATION MARK (ゝ) to U+309E HIRAGANA VOICED ITERATION MARK (ゞ)
+//   - U+1B132 HIRAGANA LETTER SMALL KO (𛄲)
+//   - U+1B150 HIRAGANA LETTER SMALL WI (𛅐) to U+1B152 HIRAGANA LETTER SMALL WO (𛅒)
+//
+// The characters not converted, such as U+309F HIRAGANA DIGRAPH YORI (ゟ)
+// and the hentaigana, are not hiragana in this sense.
+// The sound marks and U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー)
+// are not hiragana, although they are used with hiragana.
+func IsHiragana(r rune) bool {
+	return isConvertibleHiragana(r)
+}
+
+// IsKatakana reports whether r is a fullwidth katakana letter or
+// a katakana iteration mark converted to hiragana by [KatakanaToHiragana].
+//
+// The following characters are katakana:
+//
+//   - U+30A1 KATAKANA LETTER SMALL A (ァ) to U+30FA KATAKANA LETTER VO (ヺ)
+//   - U+30FD KATAKANA ITERATION MARK (ヽ) to U+30FE KATAKANA VOICED ITERATION MARK (ヾ)
+//   - U+1B155 KATAKANA LETTER SMALL KO (𛅕)
+//   - U+1B164 KATAKANA LETTER SMALL WI (𛅤) to U+1B166 KATAKANA LETTER SMALL WO (𛅦)
+//
+// The characters not converted, such as U+30FF KATAKANA DIGRAPH KOTO (ヿ)
+// and U+31F0 KATAKANA LETTER SMALL KU (ㇰ), are not katakana in this sense.
+// U+30FB KATAKANA MIDDLE DOT (・), U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー),
+// the halfwidth katakana (see [IsHalfwidthKatakana]), and
+// the circled and squared katakana are not katakana in this sense.
+func IsKatakana(r rune) bool {
+	return isConvertibleKatakana(r)
+}
+
+// IsHalfwidthKatakana reports whether r is a halfwidth katakana letter,
+// i.e. a halfwidth form converted to a katakana letter by [HalfwidthToWide].
+//
+// The following characters are halfwidth katakana:
+//
+//   - U+FF66 HALFWIDTH KATAKANA LETTER WO (ｦ) to U+FF6F HALFWIDTH KATAKANA LETTER SMALL TU (ｯ)
+//   - U+FF71 HALFWIDTH KATAKANA LETTER A (ｱ) to U+FF9D HALFWIDTH KATAKANA LETTER N (ﾝ)
+func IsHalfwidthKatakana(r rune) bool {
+	wide, ok := halfwidthMap[r]
+	return ok && IsKatakana(wide)
+}
+
+// IsFullwidthForm reports whether r is a fullwidth form
+// converted to a narrow character by [FullwidthToNarrow].
+//
+// The following characters are fullwidth forms:
+//
+//   - U+FF01 FULLWIDTH EXCLAMATION MARK (！) to U+FF60 FULLWIDTH RIGHT WHITE PARENTHESIS (｠)
+//   - U+FFE0 FULLWIDTH CENT SIGN (￠) to U+FFE6 FULLWIDTH WON SIGN (￦)
+//
+// Note that U+3000 IDEOGRAPHIC SPACE is not a fullwidth form,
+// although it is converted to U+0020 SPACE by [FullwidthToNarrow].
+func IsFullwidthForm(r rune) bool {
+	if '\uFF01' <= r && r <= '\uFF5E' {
+		return true
+	}
+	_, ok := fullwidthMap[r]
+	return ok
+}
+
+// IsHalfwidthForm reports whether r is a halfwidth form
+// converted to a wide character by [HalfwidthToWide].
+//
+// The following characters are halfwidth forms:
+//
+//   - U+FF61 HALFWIDTH IDEOGRAPHIC FULL STOP (｡) to U+FF9F HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK (ﾟ)
+//   - U+FFA0 HALFWIDTH HANGUL FILLER to U+FFDC HALFWIDTH HANGUL LETTER I (ￜ), except for the unassigned ones
+//   - U+FFE8 HALFWIDTH FORMS LIGHT VERTICAL (￨) to U+FFEE HALFWIDTH WHITE CIRCLE (￮)
+func IsHalfwidthForm(r rune) bool {
+	_, ok := halfwidthMap[r]
+	return ok
+}
+
+// IsKanaMark reports whether r is a voiced or semi-voiced sound mark
+// for kana:
+//
+//   - U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK
+//   - U+309A COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+//   - U+309B KATAKANA-HIRAGANA VOICED SOUND MARK (゛)
+//   - U+309C KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK (゜)
+//   - U+FF9E HALFWIDTH KATAKANA VOICED SOUND MARK (ﾞ)
+//   - U+FF9F HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK (ﾟ)
+func IsKanaMark(r rune) bool {
+	return isVoicedSoundMark(r)
+}
+
+// KanaAllowance specifies the characters other than kana
+// accepted by [AllHiragana] and [AllKatakana].
+// Allowances can be combined using the | operator.
+type KanaAllowance int
+
+const (
+	// AllowSpaces accepts U+0020 SPACE and U+3000 IDEOGRAPHIC SPACE.
+	AllowSpaces KanaAllowance = 1 << iota
+	// AllowProlongedSoundMark accepts
+	// U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー).
+	AllowProlongedSoundMark
+	// AllowMiddleDot accepts U+30FB KATAKANA MIDDLE DOT (・).
+	AllowMiddleDot
+)
+
+func (a KanaAllowance) allows(r rune) bool {
+	switch r {
+	case ' ', '\u3000':
+		return a&AllowSpaces != 0
+	case '\u30FC':
+		return a&AllowProlongedSoundMark != 0
+	case '\u30FB':
+		return a&AllowMiddleDot != 0
+	}
+	return false
+}
+
+// AllHiragana reports whether s consists only of hiragana
+// (see [IsHiragana]) and the characters allowed by allow.
+//
+// U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK and
+// U+309A COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK following hiragana
+// are also accepted, so that the result does not depend on the normalization form.
+// It returns true for the empty string, and false for invalid UTF-8.
+func AllHiragana(s string, allow KanaAllowance) bool {
+	return allKana(s, IsHiragana, allow)
+}
+
+// AllKatakana reports whether s consists only of katakana
+// (see [IsKatakana]) and the characters allowed by allow.
+//
+// U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK and
+// U+309A COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK following katakana
+// are also accepted, so that the result does not depend on the normalization form.
+// It returns true for the empty string, and false for invalid UTF-8.
+func AllKatakana(s string, allow KanaAllowance) bool {
+	return allKana(s, IsKatakana, allow)
+}
+
+func allKana(s string, isKana func(rune) bool, allow KanaAllowance) bool {
+	afterKana := false
+	for _, r := range s {
+		switch {
+		case isKana(r):
+			afterKana = true
+		case r == '\u3099' || r == '\u309A':
+			if !afterKana {
+				return false
+			}
+		case allow.allows(r):
+			afterKana = false
+		default:
+			// Including U+FFFD for invalid UTF-8
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,221 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestClassify(t *testing.T) {
+	testcases := []struct {
+		name      string
+		predicate func(rune) bool
+		accept    string
+		reject    string
+	}{
+		{
+			name:      "IsHiragana",
+			predicate: kana.IsHiragana,
+			accept:    "ぁあをんゔゖゝゞ\U0001B132\U0001B152",
+			reject:    "アｱーヽ゛\u3099ゟ\U0001B001🈀漢a",
+		},
+		{
+			name:      "IsKatakana",
+			predicate: kana.IsKatakana,
+			accept:    "ァアヲンヴヶヷヺヽヾ\U0001B155\U0001B166",
+			reject:    "あｱ・ー゛\u3099ヿㇰ\U0001B000\U0001B167㋐㌀漢a",
+		},
+		{
+			name:      "IsHalfwidthKatakana",
+			predicate: kana.IsHalfwidthKatakana,
+			accept:    "ｦｧｯｱﾝ",
+			reject:    "｡･ｰﾞﾟアﾡ",
+		},
+		{
+			name:      "IsFullwidthForm",
+			predicate: kana.IsFullwidthForm,
+			accept:    "！Ａｚ～｟｠￠￦",
+			reject:    "A　ｱ￨ア",
+		},
+		{
+			name:      "IsHalfwidthForm",
+			predicate: kana.IsHalfwidthForm,
+			accept:    "｡｢ｱﾝﾞﾟﾡￜ￨￮",
+			reject:    "AＡア￝￧",
+		},
+		{
+			name:      "IsKanaMark",
+			predicate: kana.IsKanaMark,
+			accept:    "\u3099\u309A゛゜ﾞﾟ",
+			reject:    "ーｰゝヽ́",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, r := range tc.accept {
+				if !tc.predicate(r) {
+					t.Errorf("%s(%U) = false, want true", tc.name, r)
+				}
+			}
+			for _, r := range tc.reject {
+				if tc.predicate(r) {
+					t.Errorf("%s(%U) = true, want false", tc.name, r)
+				}
+			}
+		})
+	}
+}
+
+// TestClassifyConsistency checks that the predicates agree with the conversion.
+func TestClassifyConsistency(t *testing.T) {
+	for r := rune(0); r <= 0x1FFFF; r++ {
+		if 0xD800 <= r && r <= 0xDFFF {
+			continue
+		}
+		s := string(r)
+		if wide := kana.Convert(s, kana.HalfwidthToWide); kana.IsHalfwidthKatakana(r) != (wide != s && kana.AllKatakana(wide, 0)) {
+			t.Errorf("IsHalfwidthKatakana(%U) disagrees with HalfwidthToWide", r)
+		}
+		if kana.IsHalfwidthForm(r) != (kana.Convert(s, kana.HalfwidthToWide) != s) {
+			t.Errorf("IsHalfwidthForm(%U) disagrees with HalfwidthToWide", r)
+		}
+		if kana.IsFullwidthForm(r) != (r != '　' && kana.Convert(s, kana.FullwidthToNarrow) != s) {
+			t.Errorf("IsFullwidthForm(%U) disagrees with FullwidthToNarrow", r)
+		}
+		if kana.IsKatakana(r) {
+			if hira := kana.Convert(s, kana.KatakanaToHiragana); hira == s || !kana.AllHiragana(hira, 0) {
+				t.Errorf("katakana %U is converted to non-hiragana %q", r, hira)
+			}
+		} else if kana.Convert(s, kana.KatakanaToHiragana) != s {
+			t.Errorf("non-katakana %U is converted by KatakanaToHiragana", r)
+		}
+		if kana.IsHiragana(r) {
+			if kata := kana.Convert(s, kana.HiraganaToKatakana); kata == s || !kana.AllKatakana(kata, 0) {
+				t.Errorf("hiragana %U is converted to non-katakana %q", r, kata)
+			}
+		} else if kana.Convert(s, kana.HiraganaToKatakana) != s {
+			t.Errorf("non-hiragana %U is converted by HiraganaToKatakana", r)
+		}
+	}
+}
+
+func TestAllKana(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  string
+		allow  kana.KanaAllowance
+		expect [2]bool // AllHiragana, AllKatakana
+	}{
+		{
+			name:   "empty",
+			input:  "",
+			expect: [2]bool{true, true},
+		},
+		{
+			name:   "hiragana",
+			input:  "やまだ",
+			expect: [2]bool{true, false},
+		},
+		{
+			name:   "katakana",
+			input:  "ヤマダ",
+			expect: [2]bool{false, true},
+		},
+		{
+			name:   "mixed",
+			input:  "やまダ",
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "halfwidth katakana",
+			input:  "ﾔﾏﾀﾞ",
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "decomposed",
+			input:  "か\u3099ヷ\u3099",
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "decomposed hiragana",
+			input:  "やまた\u3099",
+			expect: [2]bool{true, false},
+		},
+		{
+			name:   "decomposed katakana",
+			input:  "ヤマタ\u3099ヰ\u3099",
+			expect: [2]bool{false, true},
+		},
+		{
+			name:   "combining mark at the start",
+			input:  "\u3099か",
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "combining mark after space",
+			input:  "か \u3099",
+			allow:  kana.AllowSpaces,
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "spacing sound mark",
+			input:  "か゛",
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "spaces not allowed",
+			input:  "やまだ　たろう",
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "spaces allowed",
+			input:  "やまだ　たろう ヤマダ",
+			allow:  kana.AllowSpaces,
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "spaces allowed in hiragana",
+			input:  "やまだ　たろう ",
+			allow:  kana.AllowSpaces,
+			expect: [2]bool{true, false},
+		},
+		{
+			name:   "prolonged sound mark not allowed",
+			input:  "ラーメン",
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "prolonged sound mark allowed",
+			input:  "ラーメン",
+			allow:  kana.AllowProlongedSoundMark,
+			expect: [2]bool{false, true},
+		},
+		{
+			name:   "middle dot",
+			input:  "ジョン・スミス",
+			allow:  kana.AllowSpaces | kana.AllowProlongedSoundMark,
+			expect: [2]bool{false, false},
+		},
+		{
+			name:   "middle dot allowed",
+			input:  "ジョン・スミス",
+			allow:  kana.AllowMiddleDot,
+			expect: [2]bool{false, true},
+		},
+		{
+			name:   "invalid UTF-8",
+			input:  "か\xFF",
+			allow:  kana.AllowSpaces | kana.AllowProlongedSoundMark | kana.AllowMiddleDot,
+			expect: [2]bool{false, false},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := [2]bool{kana.AllHiragana(tc.input, tc.allow), kana.AllKatakana(tc.input, tc.allow)}
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 //
 //   - Fullwidth and halfwidth characters
 //   - Katakana and hiragana
+//   - Romaji and kana
 //
 // # Example
 //
@@ -19,86 +20,442 @@
 //	}
 package kana
 
+import (
+	"sync"
+	"unicode"
+)
+
 // Convert converts a string with the given options.
+//
+// If no character in the input is affected by the options,
+// the input is returned as is without allocation.
+//
+// Unless compatibility options or [NarrowToFullwidth] are given, canonically equivalent inputs
+// (e.g. ガ and カ followed by U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK,
+// as found in NFD file names on macOS) give canonically equivalent results.
 func Convert(input string, opts ConvertOptions) string {
 	opts = opts.Normalize()
-	strm := stringStream(input)
+	in := inputString(input)
+	if untouchedSpan(in, 0, opts) == len(input) {
+		return input
+	}
+	return string(appendConvert(make([]byte, 0, len(input)), in, opts))
+}
 
-	strm = convertUnconditionalCompat(strm, opts)
-	// Full <-> Half conversion
-	strm = doWidthNormalization(strm, opts)
-	strm = doKanaConversion(strm, opts)
+// AppendConvert converts a string with the given options
+// and appends the result to dst, returning the extended buffer.
+// It does not allocate if dst has enough capacity for the result.
+func AppendConvert(dst []byte, input string, opts ConvertOptions) []byte {
+	return appendConvert(dst, inputString(input), opts.Normalize())
+}
 
-	return strm.readAll()
+// ConvertBytes converts a UTF-8 encoded byte slice with the given options
+// and appends the result to dst, returning the extended buffer.
+// It does not allocate if dst has enough capacity for the result.
+//
+// src and dst must not overlap.
+func ConvertBytes(dst, src []byte, opts ConvertOptions) []byte {
+	return appendConvert(dst, inputBytes(src), opts.Normalize())
 }
 
-func convertUnconditionalCompat(strm *stream, opts ConvertOptions) *stream {
-	if opts&(CompatMinus|CompatOverline|CompatCurrency|CompatOtherSymbols) == 0 {
+// appendConvert converts in and appends the result to dst.
+//
+// Runs of characters that are not affected by the options are copied
+// as is, and only the other runs are passed through the pipeline.
+func appendConvert(dst []byte, in input, opts ConvertOptions) []byte {
+	var pl *pipeline
+	for p := 0; p < in.len(); {
+		q := p + untouchedSpan(in, p, opts)
+		dst = in.appendSlice(dst, p, q)
+		if q == in.len() {
+			break
+		}
+		r := q + touchedSpan(in, q, opts)
+		if pl == nil {
+			pl = getPipeline(pipelinePool(opts), in, opts)
+		}
+		dst = pl.appendRange(dst, q, r)
+		p = r
+	}
+	if pl != nil {
+		pl.release()
+	}
+	return dst
+}
+
+// pipeline converts ranges of an input.
+// The underlying streams are reused for all the ranges.
+type pipeline struct {
+	in   input
+	pos  int
+	end  int
+	strm *stream
+	// opts is the options the streams are built for.
+	opts ConvertOptions
+	// pool is where the pipeline is released to, if any.
+	pool *sync.Pool
+}
+
+func newPipeline(in input, opts ConvertOptions) *pipeline {
+	pl := &pipeline{in: in, opts: opts}
+	pl.strm = convertStream(inputStream(&pl.in, &pl.pos, &pl.end), opts)
+	return pl
+}
+
+// pipelinePools holds the pipelines used by [Convert], [AppendConvert],
+// and [ConvertBytes], so that the streams and their buffers are reused
+// across the calls. A pool is chosen by a hash of the options,
+// which bounds the memory however many options are used.
+var pipelinePools [16]sync.Pool
+
+// pipelinePool returns the pool of pipelines for opts.
+func pipelinePool(opts ConvertOptions) *sync.Pool {
+	// Fibonacci hashing
+	h := uint64(opts) * 0x9E3779B97F4A7C15
+	return &pipelinePools[h>>60]
+}
+
+// getPipeline returns a pipeline for in taken from pool,
+// or a new one if pool has no pipeline for opts.
+// The pipeline must be released after use.
+func getPipeline(pool *sync.Pool, in input, opts ConvertOptions) *pipeline {
+	// A pipeline for other options sharing the pool is discarded
+	if pl, ok := pool.Get().(*pipeline); ok && pl.opts == opts {
+		pl.in = in
+		return pl
+	}
+	pl := newPipeline(in, opts)
+	pl.pool = pool
+	return pl
+}
+
+// release puts the pipeline back to its pool.
+func (pl *pipeline) release() {
+	// Do not retain the input
+	pl.in = input{}
+	pl.pool.Put(pl)
+}
+
+// appendRange converts in[b:e] and appends the result to dst.
+// b and e must be segment boundaries.
+func (pl *pipeline) appendRange(dst []byte, b, e int) []byte {
+	pl.pos, pl.end = b, e
+	pl.strm.restart()
+	return pl.strm.appendAll(dst)
+}
+
+func convertStream(strm *stream, opts ConvertOptions) *stream {
+	opts = opts.Normalize()
+	if opts == 0 {
 		return strm
 	}
-	return mapStream(strm, func(ch rune) rune {
-		if opts&CompatMinus != 0 {
-			switch ch {
-			case '\u2015':
-				return '\u2014'
-			case '\uFF0D':
-				return '\u2212'
+
+	// The stages are fused into as few streams as possible so that
+	// each character is buffered only a few times.
+	// Romaji conversion needs its own stream because it looks ahead
+	// the result of the width conversion.
+	// Likewise, composition of sound marks and Hangul jamo looks ahead
+	// the result of the width conversion, and the iteration marks
+	// and the prolonged sound marks look behind the result of all the other
+	// stages but the kana conversion.
+	romaji := opts&(RomajiToHiragana|RomajiToKatakana) != 0
+	compose := opts&(ComposeVoicedSoundMarks|ComposeHangulJamo) != 0
+	iteration := opts&(ExpandIterationMarks|ExpandVerticalIterationMarks|ExpandKanjiIterationMarks|ExpandProlongedSoundMark) != 0
+	// The dashes look behind the input character.
+	var prev rune
+	strm = newStage(strm, opts, !romaji && !compose && !iteration, func(ch rune, strm *stream, buf *[]rune) {
+		ch = convertUnconditionalCompat(ch, opts)
+		ch = normalizeCompatibilityRune(ch, opts)
+		ch, prev = normalizeDashOrTilde(ch, prev, strm, opts), ch
+		ch = normalizeQuote(ch, opts)
+		if expansion, ok := expandCompatibility(ch, opts); ok {
+			for _, ch := range expansion {
+				doWidthNormalization(ch, strm, buf, opts)
 			}
+			return
 		}
-		if opts&CompatOverline != 0 {
-			switch ch {
-			case '\uFFE3':
-				return '\u203E'
+		// Full <-> Half conversion
+		doWidthNormalization(ch, strm, buf, opts)
+	})
+	strm.reset = func() {
+		prev = 0
+	}
+	if compose {
+		strm = newStage(strm, opts, !romaji && !iteration, func(ch rune, strm *stream, buf *[]rune) {
+			if composeHangulJamo(ch, strm, buf, opts) {
+				return
 			}
-		}
-		if opts&CompatCurrency != 0 {
-			switch ch {
-			case '\uFFE0':
-				return '\u00A2'
-			case '\uFFE1':
-				return '\u00A3'
-			case '\uFFE5':
-				return '\u00A5'
+			if opts&ComposeVoicedSoundMarks != 0 {
+				composeVoicedSoundMark(ch, strm, buf, opts)
+				return
 			}
-		}
-		if opts&CompatOtherSymbols != 0 {
-			switch ch {
-			case '\u2225':
-				return '\u2016'
-			case '\uFFE2':
-				return '\u00AC'
-			case '\uFFE4':
-				return '\u00A6'
+			*buf = append(*buf, ch)
+		})
+	}
+	if romaji {
+		strm = newStage(strm, opts, !iteration, func(ch rune, strm *stream, buf *[]rune) {
+			if ok := convertRomajiToKana(ch, strm, buf, opts); !ok {
+				*buf = append(*buf, ch)
+			}
+		})
+	}
+	if iteration {
+		strm = newIterationStage(strm, opts, true)
+	}
+	if opts&WideKatakanaToHalfwidth != 0 {
+		// The halfwidth conversion applies to the katakana
+		// resulting from all the other stages.
+		strm = newStage(strm, opts, false, convertKatakanaToHalfwidth)
+	}
+	return strm
+}
+
+// newStage returns a stream that converts each character read from strm
+// with convert, which appends the result to buf.
+// If withKana is true, the result is further passed to the kana conversion.
+func newStage(strm *stream, opts ConvertOptions, withKana bool, convert func(ch rune, strm *stream, buf *[]rune)) *stream {
+	withKana = withKana && opts&(KatakanaToHiragana|HiraganaToKatakana|SmallKanaToLarge|StripVoicedSoundMarks|NarrowToFullwidth) != 0
+	var scratch []rune
+	stage := newStream(func(buf *[]rune) {
+		// A character may be converted to nothing, but the stage must
+		// produce at least one character unless strm ends,
+		// as producing nothing means the end of the stream.
+		begin := len(*buf)
+		for i := 0; i < streamChunkSize || len(*buf) == begin; i++ {
+			ch, ok := strm.readOne()
+			if !ok {
+				return
+			}
+
+			start := len(*buf)
+			convert(ch, strm, buf)
+			if withKana {
+				scratch = append(scratch[:0], (*buf)[start:]...)
+				*buf = (*buf)[:start]
+				for _, ch := range scratch {
+					doKanaConversion(ch, buf, opts)
+				}
 			}
 		}
-		return ch
 	})
+	stage.src = strm
+	return stage
 }
 
-func doWidthNormalization(strm *stream, opts ConvertOptions) *stream {
-	if opts&(FullwidthToNarrow|CompatWideKatakanaToHalfwidth|HalfwidthToWide) == 0 {
-		return strm
+func convertUnconditionalCompat(ch rune, opts ConvertOptions) rune {
+	if opts&(CompatMinus|CompatOverline|CompatCurrency|CompatOtherSymbols) == 0 {
+		return ch
 	}
-	return newStream(func(buf *[]rune) {
-		ch, ok := strm.readOne()
-		if !ok {
-			return
+	if opts&CompatMinus != 0 {
+		switch ch {
+		case '\u2015':
+			return '\u2014'
+		case '\uFF0D':
+			return '\u2212'
 		}
-
-		if ok := convertFullwidthToNarrow(ch, buf, opts); ok {
-			// Do nothing
-		} else if ok := convertWideKatakanaToHalfwidth(ch, buf, opts); ok {
-			// Do nothing
-		} else if ok := convertHalfwidthToWide(ch, strm, buf, opts); ok {
-			// Do nothing
-		} else {
-			*buf = append(*buf, ch)
+	}
+	if opts&CompatOverline != 0 {
+		switch ch {
+		case '\uFFE3':
+			return '\u203E'
 		}
-	})
+	}
+	if opts&CompatCurrency != 0 {
+		switch ch {
+		case '\uFFE0':
+			return '\u00A2'
+		case '\uFFE1':
+			return '\u00A3'
+		case '\uFFE5':
+			return '\u00A5'
+		}
+	}
+	if opts&CompatOtherSymbols != 0 {
+		switch ch {
+		case '\u2225':
+			return '\u2016'
+		case '\uFFE2':
+			return '\u00AC'
+		case '\uFFE4':
+			return '\u00A6'
+		}
+	}
+	return ch
+}
+
+// expandKanaCompatibility returns the kana sequence that ch is expanded to
+// by [ExpandKanaCompatibility].
+func expandKanaCompatibility(ch rune, opts ConvertOptions) (string, bool) {
+	if opts&ExpandKanaCompatibility == 0 {
+		return "", false
+	}
+	expansion, ok := kanaCompatibilityTable[ch]
+	return expansion, ok
+}
+
+var kanaCompatibilityTable = map[rune]string{
+	'\u309F':     "\u3088\u308A",
+	'\u30FF':     "\u30B3\u30C8",
+	'\u32D0':     "\u30A2",
+	'\u32D1':     "\u30A4",
+	'\u32D2':     "\u30A6",
+	'\u32D3':     "\u30A8",
+	'\u32D4':     "\u30AA",
+	'\u32D5':     "\u30AB",
+	'\u32D6':     "\u30AD",
+	'\u32D7':     "\u30AF",
+	'\u32D8':     "\u30B1",
+	'\u32D9':     "\u30B3",
+	'\u32DA':     "\u30B5",
+	'\u32DB':     "\u30B7",
+	'\u32DC':     "\u30B9",
+	'\u32DD':     "\u30BB",
+	'\u32DE':     "\u30BD",
+	'\u32DF':     "\u30BF",
+	'\u32E0':     "\u30C1",
+	'\u32E1':     "\u30C4",
+	'\u32E2':     "\u30C6",
+	'\u32E3':     "\u30C8",
+	'\u32E4':     "\u30CA",
+	'\u32E5':     "\u30CB",
+	'\u32E6':     "\u30CC",
+	'\u32E7':     "\u30CD",
+	'\u32E8':     "\u30CE",
+	'\u32E9':     "\u30CF",
+	'\u32EA':     "\u30D2",
+	'\u32EB':     "\u30D5",
+	'\u32EC':     "\u30D8",
+	'\u32ED':     "\u30DB",
+	'\u32EE':     "\u30DE",
+	'\u32EF':     "\u30DF",
+	'\u32F0':     "\u30E0",
+	'\u32F1':     "\u30E1",
+	'\u32F2':     "\u30E2",
+	'\u32F3':     "\u30E4",
+	'\u32F4':     "\u30E6",
+	'\u32F5':     "\u30E8",
+	'\u32F6':     "\u30E9",
+	'\u32F7':     "\u30EA",
+	'\u32F8':     "\u30EB",
+	'\u32F9':     "\u30EC",
+	'\u32FA':     "\u30ED",
+	'\u32FB':     "\u30EF",
+	'\u32FC':     "\u30F0",
+	'\u32FD':     "\u30F1",
+	'\u32FE':     "\u30F2",
+	'\u3300':     "\u30A2\u30D1\u30FC\u30C8",
+	'\u3301':     "\u30A2\u30EB\u30D5\u30A1",
+	'\u3302':     "\u30A2\u30F3\u30DA\u30A2",
+	'\u3303':     "\u30A2\u30FC\u30EB",
+	'\u3304':     "\u30A4\u30CB\u30F3\u30B0",
+	'\u3305':     "\u30A4\u30F3\u30C1",
+	'\u3306':     "\u30A6\u30A9\u30F3",
+	'\u3307':     "\u30A8\u30B9\u30AF\u30FC\u30C9",
+	'\u3308':     "\u30A8\u30FC\u30AB\u30FC",
+	'\u3309':     "\u30AA\u30F3\u30B9",
+	'\u330A':     "\u30AA\u30FC\u30E0",
+	'\u330B':     "\u30AB\u30A4\u30EA",
+	'\u330C':     "\u30AB\u30E9\u30C3\u30C8",
+	'\u330D':     "\u30AB\u30ED\u30EA\u30FC",
+	'\u330E':     "\u30AC\u30ED\u30F3",
+	'\u330F':     "\u30AC\u30F3\u30DE",
+	'\u3310':     "\u30AE\u30AC",
+	'\u3311':     "\u30AE\u30CB\u30FC",
+	'\u3312':     "\u30AD\u30E5\u30EA\u30FC",
+	'\u3313':     "\u30AE\u30EB\u30C0\u30FC",
+	'\u3314':     "\u30AD\u30ED",
+	'\u3315':     "\u30AD\u30ED\u30B0\u30E9\u30E0",
+	'\u3316':     "\u30AD\u30ED\u30E1\u30FC\u30C8\u30EB",
+	'\u3317':     "\u30AD\u30ED\u30EF\u30C3\u30C8",
+	'\u3318':     "\u30B0\u30E9\u30E0",
+	'\u3319':     "\u30B0\u30E9\u30E0\u30C8\u30F3",
+	'\u331A':     "\u30AF\u30EB\u30BC\u30A4\u30ED",
+	'\u331B':     "\u30AF\u30ED\u30FC\u30CD",
+	'\u331C':     "\u30B1\u30FC\u30B9",
+	'\u331D':     "\u30B3\u30EB\u30CA",
+	'\u331E':     "\u30B3\u30FC\u30DD",
+	'\u331F':     "\u30B5\u30A4\u30AF\u30EB",
+	'\u3320':     "\u30B5\u30F3\u30C1\u30FC\u30E0",
+	'\u3321':     "\u30B7\u30EA\u30F3\u30B0",
+	'\u3322':     "\u30BB\u30F3\u30C1",
+	'\u3323':     "\u30BB\u30F3\u30C8",
+	'\u3324':     "\u30C0\u30FC\u30B9",
+	'\u3325':     "\u30C7\u30B7",
+	'\u3326':     "\u30C9\u30EB",
+	'\u3327':     "\u30C8\u30F3",
+	'\u3328':     "\u30CA\u30CE",
+	'\u3329':     "\u30CE\u30C3\u30C8",
+	'\u332A':     "\u30CF\u30A4\u30C4",
+	'\u332B':     "\u30D1\u30FC\u30BB\u30F3\u30C8",
+	'\u332C':     "\u30D1\u30FC\u30C4",
+	'\u332D':     "\u30D0\u30FC\u30EC\u30EB",
+	'\u332E':     "\u30D4\u30A2\u30B9\u30C8\u30EB",
+	'\u332F':     "\u30D4\u30AF\u30EB",
+	'\u3330':     "\u30D4\u30B3",
+	'\u3331':     "\u30D3\u30EB",
+	'\u3332':     "\u30D5\u30A1\u30E9\u30C3\u30C9",
+	'\u3333':     "\u30D5\u30A3\u30FC\u30C8",
+	'\u3334':     "\u30D6\u30C3\u30B7\u30A7\u30EB",
+	'\u3335':     "\u30D5\u30E9\u30F3",
+	'\u3336':     "\u30D8\u30AF\u30BF\u30FC\u30EB",
+	'\u3337':     "\u30DA\u30BD",
+	'\u3338':     "\u30DA\u30CB\u30D2",
+	'\u3339':     "\u30D8\u30EB\u30C4",
+	'\u333A':     "\u30DA\u30F3\u30B9",
+	'\u333B':     "\u30DA\u30FC\u30B8",
+	'\u333C':     "\u30D9\u30FC\u30BF",
+	'\u333D':     "\u30DD\u30A4\u30F3\u30C8",
+	'\u333E':     "\u30DC\u30EB\u30C8",
+	'\u333F':     "\u30DB\u30F3",
+	'\u3340':     "\u30DD\u30F3\u30C9",
+	'\u3341':     "\u30DB\u30FC\u30EB",
+	'\u3342':     "\u30DB\u30FC\u30F3",
+	'\u3343':     "\u30DE\u30A4\u30AF\u30ED",
+	'\u3344':     "\u30DE\u30A4\u30EB",
+	'\u3345':     "\u30DE\u30C3\u30CF",
+	'\u3346':     "\u30DE\u30EB\u30AF",
+	'\u3347':     "\u30DE\u30F3\u30B7\u30E7\u30F3",
+	'\u3348':     "\u30DF\u30AF\u30ED\u30F3",
+	'\u3349':     "\u30DF\u30EA",
+	'\u334A':     "\u30DF\u30EA\u30D0\u30FC\u30EB",
+	'\u334B':     "\u30E1\u30AC",
+	'\u334C':     "\u30E1\u30AC\u30C8\u30F3",
+	'\u334D':     "\u30E1\u30FC\u30C8\u30EB",
+	'\u334E':     "\u30E4\u30FC\u30C9",
+	'\u334F':     "\u30E4\u30FC\u30EB",
+	'\u3350':     "\u30E6\u30A2\u30F3",
+	'\u3351':     "\u30EA\u30C3\u30C8\u30EB",
+	'\u3352':     "\u30EA\u30E9",
+	'\u3353':     "\u30EB\u30D4\u30FC",
+	'\u3354':     "\u30EB\u30FC\u30D6\u30EB",
+	'\u3355':     "\u30EC\u30E0",
+	'\u3356':     "\u30EC\u30F3\u30C8\u30B2\u30F3",
+	'\u3357':     "\u30EF\u30C3\u30C8",
+	'\U0001F200': "\u307B\u304B",
+	'\U0001F201': "\u30B3\u30B3",
+	'\U0001F202': "\u30B5",
+}
+
+// doWidthNormalization converts ch and appends the result to buf.
+// It may consume the following characters from strm.
+//
+// Note that the following characters are not processed by
+// convertUnconditionalCompat, which never produces or consumes
+// the characters looked ahead here.
+func doWidthNormalization(ch rune, strm *stream, buf *[]rune, opts ConvertOptions) {
+	if ok := convertFullwidthToNarrow(ch, buf, opts); ok {
+		// Do nothing
+	} else if ok := convertWideKatakanaToHalfwidth(ch, buf, opts); ok {
+		// Do nothing
+	} else if ok := convertHalfwidthToWide(ch, strm, buf, opts); ok {
+		// Do nothing
+	} else {
+		*buf = append(*buf, ch)
+	}
 }
 
 func convertFullwidthToNarrow(ch rune, buf *[]rune, opts ConvertOptions) bool {
-	if opts&FullwidthToNarrow == 0 {
+	if opts&FullwidthToNarrow == 0 || opts&narrowingClass(ch) == 0 {
 		return false
 	}
 	if opts&CompatQuotes != 0 {
@@ -161,6 +518,8 @@ func convertFullwidthToNarrow(ch rune, buf *[]rune, opts ConvertOptions) bool {
 	} else if ch == '\u3000' {
 		*buf = append(*buf, ' ')
 		return true
+	} else if ch < '\uFF5F' || ch > '\uFFE6' {
+		return false
 	} else if mapped, ok := fullwidthMap[ch]; ok {
 		*buf = append(*buf, mapped)
 		return true
@@ -168,6 +527,64 @@ func convertFullwidthToNarrow(ch rune, buf *[]rune, opts ConvertOptions) bool {
 	return false
 }
 
+// narrowingClass returns the option in [FullwidthToNarrow]
+// that converts ch.
+func narrowingClass(ch rune) ConvertOptions {
+	switch {
+	case ch == '\u3000':
+		return FullwidthSpaceToNarrow
+	case '\uFF10' <= ch && ch <= '\uFF19' || '\uFF21' <= ch && ch <= '\uFF3A' || '\uFF41' <= ch && ch <= '\uFF5A':
+		return FullwidthAlnumToNarrow
+	case unicode.IsPunct(ch):
+		return FullwidthPunctuationToNarrow
+	}
+	return FullwidthSymbolsToNarrow
+}
+
+// wideningClass returns the option in [NarrowToFullwidth]
+// that converts a character to its fullwidth form wide.
+func wideningClass(wide rune) ConvertOptions {
+	switch narrowingClass(wide) {
+	case FullwidthSpaceToNarrow:
+		return NarrowSpaceToFullwidth
+	case FullwidthAlnumToNarrow:
+		return NarrowAlnumToFullwidth
+	case FullwidthPunctuationToNarrow:
+		return NarrowPunctuationToFullwidth
+	}
+	return NarrowSymbolsToFullwidth
+}
+
+func convertNarrowToFullwidth(ch rune, buf *[]rune, opts ConvertOptions) bool {
+	if opts&NarrowToFullwidth == 0 {
+		return false
+	}
+	var wide rune
+	if ch == ' ' {
+		wide = '\u3000'
+	} else if '!' <= ch && ch <= '~' {
+		wide = ch - ' ' + '\uFF00'
+	} else if mapped, ok := narrowMap[ch]; ok {
+		wide = mapped
+	} else {
+		return false
+	}
+	if opts&wideningClass(wide) == 0 {
+		return false
+	}
+	*buf = append(*buf, wide)
+	return true
+}
+
+// narrowMap is the inverse of fullwidthMap.
+var narrowMap = func() map[rune]rune {
+	m := make(map[rune]rune, len(fullwidthMap))
+	for wide, narrow := range fullwidthMap {
+		m[narrow] = wide
+	}
+	return m
+}()
+
 var fullwidthMap = map[rune]rune{
 	'\uFF5F': '\u2985',
 	'\uFF60': '\u2986',
@@ -288,12 +705,56 @@ var fullwidthKatakanaTable = map[rune]string{
 	'\u30FC': "\uFF70",
 }
 
+// convertKatakanaToHalfwidth appends ch converted by [WideKatakanaToHalfwidth].
+// If the result ends with a halfwidth katakana, the combining sound marks
+// following ch are converted together, so that the result does not depend
+// on the normalization form.
+func convertKatakanaToHalfwidth(ch rune, strm *stream, buf *[]rune) {
+	switch ch {
+	case '\u309B':
+		*buf = append(*buf, '\uFF9E')
+		return
+	case '\u309C':
+		*buf = append(*buf, '\uFF9F')
+		return
+	}
+	mapped, ok := halfwidthKatakanaTable[ch]
+	if !ok && '\u30A1' <= ch && ch <= '\u30FC' {
+		mapped, ok = fullwidthKatakanaTable[ch]
+	}
+	if !ok {
+		*buf = append(*buf, ch)
+		if ch < '\uFF66' || '\uFF9F' < ch {
+			return
+		}
+	}
+	for _, mappedCh := range mapped {
+		*buf = append(*buf, mappedCh)
+	}
+	for {
+		next, ok := strm.peekOne()
+		if !ok || next != '\u3099' && next != '\u309A' {
+			return
+		}
+		strm.consume(1)
+		*buf = append(*buf, next-'\u3099'+'\uFF9E')
+	}
+}
+
+// halfwidthKatakanaTable complements fullwidthKatakanaTable
+// with the letters omitted for NKF compatibility.
+var halfwidthKatakanaTable = map[rune]string{
+	'\u30F7': "\uFF9C\uFF9E",
+	'\u30FA': "\uFF66\uFF9E",
+}
+
 func convertHalfwidthToWide(ch rune, strm *stream, buf *[]rune, opts ConvertOptions) bool {
 	if opts&HalfwidthToWide == 0 {
 		return false
 	}
-	if opts&CompatVoicedSoundMarks != 0 {
-		// Use a non-combining version
+	if opts&(CompatVoicedSoundMarks|WideKatakanaToHalfwidth) != 0 {
+		// Use a non-combining version, which is converted back
+		// to the halfwidth one by WideKatakanaToHalfwidth
 		switch ch {
 		case '\uFF9E':
 			*buf = append(*buf, '\u309B')
@@ -417,7 +878,8 @@ var halfwidthMap = map[rune]rune{
 	// just as Unicode does for the proper Hangul Jamos.
 	// They are merely for round-trip compatibility with legacy encodings.
 	// To align with how Unicode handles these characters, we do not try
-	// to determine the consonant type or compose them into a precomposed syllable.
+	// to determine the consonant type or compose them into a precomposed syllable
+	// unless ComposeHangulJamo is given.
 	'\uFFA0': '\u3164',
 	'\uFFA1': '\u3131',
 	'\uFFA2': '\u3132',
@@ -516,24 +978,221 @@ var halfwidthSemiVoicedKatakanaTable = map[rune]rune{
 	'\uFF8E': '\u30DD',
 }
 
-func doKanaConversion(strm *stream, opts ConvertOptions) *stream {
-	if opts&(KatakanaToHiragana|HiraganaToKatakana) == 0 {
-		return strm
+// doKanaConversion converts ch and appends the result to buf.
+//
+// [NarrowToFullwidth] is also applied here, at the end of the pipeline,
+// so that the other stages see the narrow characters (e.g. romaji).
+func doKanaConversion(ch rune, buf *[]rune, opts ConvertOptions) {
+	if ok := convertNarrowToFullwidth(ch, buf, opts); ok {
+		return
+	}
+	ch = convertSmallKanaToLarge(ch, opts)
+	if opts&StripVoicedSoundMarks != 0 {
+		if isVoicedSoundMark(ch) {
+			return
+		}
+		if base, ok := voicedKanaBaseTable[ch]; ok {
+			ch = base
+		}
 	}
-	return newStream(func(buf *[]rune) {
-		ch, ok := strm.readOne()
-		if !ok {
+	if ok := convertKatakanaToHiragana(ch, buf, opts); ok {
+		// Do nothing
+	} else if ok := convertHiraganaToKatakana(ch, buf, opts); ok {
+		// Do nothing
+	} else {
+		*buf = append(*buf, ch)
+	}
+}
+
+func convertSmallKanaToLarge(ch rune, opts ConvertOptions) rune {
+	if opts&SmallKanaToLarge == 0 {
+		return ch
+	}
+	if ch >= '\u3041' && ch <= '\u3096' || ch >= '\u30A1' && ch <= '\u30F6' {
+		switch ch {
+		case '\u3041', '\u3043', '\u3045', '\u3047', '\u3049', '\u3063', '\u3083', '\u3085', '\u3087', '\u308E',
+			'\u30A1', '\u30A3', '\u30A5', '\u30A7', '\u30A9', '\u30C3', '\u30E3', '\u30E5', '\u30E7', '\u30EE':
+			return ch + 1
+		case '\u3095':
+			return '\u304B'
+		case '\u3096':
+			return '\u3051'
+		case '\u30F5':
+			return '\u30AB'
+		case '\u30F6':
+			return '\u30B1'
+		}
+		return ch
+	}
+	if ch >= '\u31F0' && ch <= '\u31FF' {
+		return smallKatakanaExtensionTable[ch-'\u31F0']
+	}
+	if ch >= '\uFF67' && ch <= '\uFF6F' {
+		return smallHalfwidthKatakanaTable[ch-'\uFF67']
+	}
+	switch ch {
+	case '\U0001B132':
+		return '\u3053'
+	case '\U0001B150':
+		return '\u3090'
+	case '\U0001B151':
+		return '\u3091'
+	case '\U0001B152':
+		return '\u3092'
+	case '\U0001B155':
+		return '\u30B3'
+	case '\U0001B164':
+		return '\u30F0'
+	case '\U0001B165':
+		return '\u30F1'
+	case '\U0001B166':
+		return '\u30F2'
+	case '\U0001B167':
+		return '\u30F3'
+	}
+	return ch
+}
+
+// composeVoicedSoundMark appends ch composed with the following
+// voiced or semi-voiced sound mark if possible.
+// Otherwise, it appends ch with the spacing sound marks replaced
+// with the combining ones, unless they are to be converted
+// to the halfwidth ones by WideKatakanaToHalfwidth.
+func composeVoicedSoundMark(ch rune, strm *stream, buf *[]rune, opts ConvertOptions) {
+	if next, ok := strm.peekOne(); ok {
+		var composed rune
+		switch next {
+		case '\u3099', '\u309B':
+			composed = voicedKanaTable[ch]
+		case '\u309A', '\u309C':
+			composed = semiVoicedKanaTable[ch]
+		}
+		if composed != 0 {
+			strm.consume(1)
+			*buf = append(*buf, composed)
 			return
 		}
+	}
+	if opts&WideKatakanaToHalfwidth == 0 {
+		switch ch {
+		case '\u309B':
+			ch = '\u3099'
+		case '\u309C':
+			ch = '\u309A'
+		}
+	}
+	*buf = append(*buf, ch)
+}
 
-		if ok := convertKatakanaToHiragana(ch, buf, opts); ok {
-			// Do nothing
-		} else if ok := convertHiraganaToKatakana(ch, buf, opts); ok {
-			// Do nothing
+func isVoicedSoundMark(ch rune) bool {
+	return '\u3099' <= ch && ch <= '\u309C' || ch == '\uFF9E' || ch == '\uFF9F'
+}
+
+// voicedKanaBaseTable maps voiced and semi-voiced kana
+// to the kana without the sound marks.
+var voicedKanaBaseTable = map[rune]rune{
+	'\u304C': '\u304B',
+	'\u304E': '\u304D',
+	'\u3050': '\u304F',
+	'\u3052': '\u3051',
+	'\u3054': '\u3053',
+	'\u3056': '\u3055',
+	'\u3058': '\u3057',
+	'\u305A': '\u3059',
+	'\u305C': '\u305B',
+	'\u305E': '\u305D',
+	'\u3060': '\u305F',
+	'\u3062': '\u3061',
+	'\u3065': '\u3064',
+	'\u3067': '\u3066',
+	'\u3069': '\u3068',
+	'\u3070': '\u306F',
+	'\u3071': '\u306F',
+	'\u3073': '\u3072',
+	'\u3074': '\u3072',
+	'\u3076': '\u3075',
+	'\u3077': '\u3075',
+	'\u3079': '\u3078',
+	'\u307A': '\u3078',
+	'\u307C': '\u307B',
+	'\u307D': '\u307B',
+	'\u3094': '\u3046',
+	'\u309E': '\u309D',
+	'\u30AC': '\u30AB',
+	'\u30AE': '\u30AD',
+	'\u30B0': '\u30AF',
+	'\u30B2': '\u30B1',
+	'\u30B4': '\u30B3',
+	'\u30B6': '\u30B5',
+	'\u30B8': '\u30B7',
+	'\u30BA': '\u30B9',
+	'\u30BC': '\u30BB',
+	'\u30BE': '\u30BD',
+	'\u30C0': '\u30BF',
+	'\u30C2': '\u30C1',
+	'\u30C5': '\u30C4',
+	'\u30C7': '\u30C6',
+	'\u30C9': '\u30C8',
+	'\u30D0': '\u30CF',
+	'\u30D1': '\u30CF',
+	'\u30D3': '\u30D2',
+	'\u30D4': '\u30D2',
+	'\u30D6': '\u30D5',
+	'\u30D7': '\u30D5',
+	'\u30D9': '\u30D8',
+	'\u30DA': '\u30D8',
+	'\u30DC': '\u30DB',
+	'\u30DD': '\u30DB',
+	'\u30F4': '\u30A6',
+	'\u30F7': '\u30EF',
+	'\u30F8': '\u30F0',
+	'\u30F9': '\u30F1',
+	'\u30FA': '\u30F2',
+	'\u30FE': '\u30FD',
+}
+
+// voicedKanaTable and semiVoicedKanaTable map kana to their voiced
+// and semi-voiced versions, respectively. They are the inverse of
+// voicedKanaBaseTable.
+var voicedKanaTable, semiVoicedKanaTable = func() (map[rune]rune, map[rune]rune) {
+	voiced := map[rune]rune{}
+	semiVoiced := map[rune]rune{}
+	for composed, base := range voicedKanaBaseTable {
+		// Semi-voiced kana immediately follow the voiced ones
+		if composed-base == 2 {
+			semiVoiced[base] = composed
 		} else {
-			*buf = append(*buf, ch)
+			voiced[base] = composed
 		}
-	})
+	}
+	return voiced, semiVoiced
+}()
+
+// smallKatakanaExtensionTable maps U+31F0 to U+31FF
+// (Katakana Phonetic Extensions) to the full-size letters.
+var smallKatakanaExtensionTable = [16]rune{
+	'\u30AF', '\u30B7', '\u30B9', '\u30C8', '\u30CC', '\u30CF', '\u30D2', '\u30D5',
+	'\u30D8', '\u30DB', '\u30E0', '\u30E9', '\u30EA', '\u30EB', '\u30EC', '\u30ED',
+}
+
+// smallHalfwidthKatakanaTable maps U+FF67 to U+FF6F
+// to the full-size halfwidth letters.
+var smallHalfwidthKatakanaTable = [9]rune{
+	'\uFF71', '\uFF72', '\uFF73', '\uFF74', '\uFF75', '\uFF94', '\uFF95', '\uFF96', '\uFF82',
+}
+
+// isConvertibleKatakana reports whether ch is converted by [KatakanaToHiragana],
+// which is also what [IsKatakana] reports.
+func isConvertibleKatakana(ch rune) bool {
+	return '\u30A1' <= ch && ch <= '\u30FA' || '\u30FD' <= ch && ch <= '\u30FE' ||
+		ch == '\U0001B155' || '\U0001B164' <= ch && ch <= '\U0001B166'
+}
+
+// isConvertibleHiragana reports whether ch is converted by [HiraganaToKatakana],
+// which is also what [IsHiragana] reports.
+func isConvertibleHiragana(ch rune) bool {
+	return '\u3041' <= ch && ch <= '\u3096' || '\u309D' <= ch && ch <= '\u309E' ||
+		ch == '\U0001B132' || '\U0001B150' <= ch && ch <= '\U0001B152'
 }
 
 func convertKatakanaToHiragana(ch rune, buf *[]rune, opts ConvertOptions) bool {
@@ -543,38 +1202,31 @@ func convertKatakanaToHiragana(ch rune, buf *[]rune, opts ConvertOptions) bool {
 	if opts&CompatKanaRestriction != 0 && !(ch >= '\u30A1' && ch <= '\u30F4' || ch >= '\u30FD' && ch <= '\u30FE') {
 		return false
 	}
-
-	if ch >= '\u30A1' && ch <= '\u30F4' || ch >= '\u30F5' && ch <= '\u30F6' || ch >= '\u30FD' && ch <= '\u30FE' {
-		*buf = append(*buf, ch-'\u30A0'+'\u3040')
-		return true
+	if !isConvertibleKatakana(ch) {
+		return false
 	}
+
 	switch ch {
 	case '\u30F7':
 		*buf = append(*buf, '\u308F', '\u3099')
-		return true
 	case '\u30F8':
 		*buf = append(*buf, '\u3090', '\u3099')
-		return true
 	case '\u30F9':
 		*buf = append(*buf, '\u3091', '\u3099')
-		return true
 	case '\u30FA':
 		*buf = append(*buf, '\u3092', '\u3099')
-		return true
 	case '\U0001B155':
 		*buf = append(*buf, '\U0001B132')
-		return true
 	case '\U0001B164':
 		*buf = append(*buf, '\U0001B150')
-		return true
 	case '\U0001B165':
 		*buf = append(*buf, '\U0001B151')
-		return true
 	case '\U0001B166':
 		*buf = append(*buf, '\U0001B152')
-		return true
+	default:
+		*buf = append(*buf, ch-'\u30A0'+'\u3040')
 	}
-	return false
+	return true
 }
 
 func convertHiraganaToKatakana(ch rune, buf *[]rune, opts ConvertOptions) bool {
@@ -584,24 +1236,21 @@ func convertHiraganaToKatakana(ch rune, buf *[]rune, opts ConvertOptions) bool {
 	if opts&CompatKanaRestriction != 0 && !(ch >= '\u3041' && ch <= '\u3094' || ch >= '\u309D' && ch <= '\u309E') {
 		return false
 	}
-
-	if ch >= '\u3041' && ch <= '\u3094' || ch >= '\u3095' && ch <= '\u3096' || ch >= '\u309D' && ch <= '\u309E' {
-		*buf = append(*buf, ch-'\u3040'+'\u30A0')
-		return true
+	if !isConvertibleHiragana(ch) {
+		return false
 	}
+
 	switch ch {
 	case '\U0001B132':
 		*buf = append(*buf, '\U0001B155')
-		return true
 	case '\U0001B150':
 		*buf = append(*buf, '\U0001B164')
-		return true
 	case '\U0001B151':
 		*buf = append(*buf, '\U0001B165')
-		return true
 	case '\U0001B152':
 		*buf = append(*buf, '\U0001B166')
-		return true
+	default:
+		*buf = append(*buf, ch-'\u3040'+'\u30A0')
 	}
-	return false
+	return true
 }
@@ -0,0 +1,125 @@
+package kana_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/wantedly/kana-go"
+)
+
+var benchmarkInputs = []struct {
+	name  string
+	chunk string
+}{
+	{name: "ASCII", chunk: "The quick brown fox jumps over the lazy dog. "},
+	{name: "Japanese", chunk: "吾輩は猫である。名前はまだ無い。どこで生れたかとんと見当がつかぬ。"},
+	{name: "Mixed", chunk: "ｶﾀｶﾅとＡＢＣと漢字とabcとカタカナ。"},
+	{name: "Halfwidth", chunk: "ﾊﾟｿｺﾝｶﾞｷﾞｸﾞｹﾞｺﾞﾊﾟﾋﾟﾌﾟﾍﾟﾎﾟ"},
+	// Every character is changed, alternating between the conversions.
+	{name: "WorstCase", chunk: "ｶﾞＡﾊﾟ　ｱカﾞ"},
+}
+
+var benchmarkSizes = []int{1 << 10, 1 << 14, 1 << 18}
+
+func benchmarkInput(chunk string, size int) string {
+	return strings.Repeat(chunk, size/len(chunk)+1)[:size]
+}
+
+// BenchmarkConvert measures Convert for inputs of increasing sizes.
+// The throughput (MB/s) should stay roughly constant across the sizes,
+// since the conversion is linear in the input size.
+func BenchmarkConvert(b *testing.B) {
+	opts := kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana
+	for _, bi := range benchmarkInputs {
+		for _, size := range benchmarkSizes {
+			input := benchmarkInput(bi.chunk, size)
+			b.Run(fmt.Sprintf("%s/%d", bi.name, size), func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(input)))
+				for i := 0; i < b.N; i++ {
+					kana.Convert(input, opts)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkConvertBytes(b *testing.B) {
+	opts := kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana
+	for _, bi := range benchmarkInputs {
+		for _, size := range benchmarkSizes {
+			input := []byte(benchmarkInput(bi.chunk, size))
+			b.Run(fmt.Sprintf("%s/%d", bi.name, size), func(b *testing.B) {
+				dst := make([]byte, 0, 2*len(input))
+				b.ReportAllocs()
+				b.SetBytes(int64(len(input)))
+				for i := 0; i < b.N; i++ {
+					dst = kana.ConvertBytes(dst[:0], input, opts)
+				}
+			})
+		}
+	}
+}
+
+// TestConvertAllocations checks that the number of allocations
+// does not grow with the input size.
+func TestConvertAllocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not counted with the race detector")
+	}
+	opts := kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana
+	for _, bi := range benchmarkInputs {
+		t.Run(bi.name, func(t *testing.T) {
+			small := []byte(benchmarkInput(bi.chunk, benchmarkSizes[0]))
+			large := []byte(benchmarkInput(bi.chunk, benchmarkSizes[len(benchmarkSizes)-1]))
+			dst := make([]byte, 0, 2*len(large))
+
+			smallAllocs := testing.AllocsPerRun(10, func() {
+				kana.ConvertBytes(dst[:0], small, opts)
+			})
+			largeAllocs := testing.AllocsPerRun(10, func() {
+				kana.ConvertBytes(dst[:0], large, opts)
+			})
+			if largeAllocs > smallAllocs {
+				t.Errorf("allocations grow with the input size: %v for %d bytes, %v for %d bytes", smallAllocs, len(small), largeAllocs, len(large))
+			}
+		})
+	}
+}
+
+// TestConvertNoAllocation checks that Convert does not allocate
+// when the input is not affected by the options.
+func TestConvertNoAllocation(t *testing.T) {
+	testcases := []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+	}{
+		{
+			name:    "ASCII",
+			input:   "The quick brown fox jumps over the lazy dog.",
+			options: kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana,
+		},
+		{
+			name:    "Normalized Japanese",
+			input:   "吾輩は猫である。名前はまだ無い。",
+			options: kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana,
+		},
+		{
+			name:    "No options",
+			input:   "ｶﾀｶﾅとＡＢＣ",
+			options: 0,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(10, func() {
+				kana.Convert(tc.input, tc.options)
+			})
+			if allocs != 0 {
+				t.Errorf("unexpected allocations: %v", allocs)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestConvertBytes(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := "prefix:" + kana.Convert(tc.input, tc.options)
+
+			actual := kana.ConvertBytes([]byte("prefix:"), []byte(tc.input), tc.options)
+			if diff := cmp.Diff(expect, string(actual)); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppendConvert(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := "prefix:" + kana.Convert(tc.input, tc.options)
+
+			actual := kana.AppendConvert([]byte("prefix:"), tc.input, tc.options)
+			if diff := cmp.Diff(expect, string(actual)); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertBytesReuseBuffer(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	out := kana.ConvertBytes(buf, []byte("ＡＢＣ"), kana.FullwidthToNarrow)
+	if diff := cmp.Diff("ABC", string(out)); diff != "" {
+		t.Errorf("unexpected diff (-want +got):\n%s", diff)
+	}
+	if &out[0] != &buf[:1][0] {
+		t.Errorf("expected the result to be written to the given buffer")
+	}
+}
+
+func TestConvertBytesAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not counted with the race detector")
+	}
+	str := "ｶﾞｷﾞｸﾞ ＡＢＣ kyouto いすゞ"
+	src := []byte(str)
+	for _, opts := range []kana.ConvertOptions{
+		kana.HalfwidthToWide | kana.FullwidthToNarrow,
+		kana.HalfwidthToWide | kana.KatakanaToHiragana | kana.RomajiToHiragana | kana.ExpandIterationMarks,
+		kana.SearchNormalize,
+	} {
+		t.Run(opts.String(), func(t *testing.T) {
+			dst := make([]byte, 0, 4*len(src))
+			allocs := testing.AllocsPerRun(100, func() {
+				dst = kana.ConvertBytes(dst[:0], src, opts)
+			})
+			if allocs != 0 {
+				t.Errorf("unexpected allocations in ConvertBytes: %v", allocs)
+			}
+			allocs = testing.AllocsPerRun(100, func() {
+				dst = kana.AppendConvert(dst[:0], str, opts)
+			})
+			if allocs != 0 {
+				t.Errorf("unexpected allocations in AppendConvert: %v", allocs)
+			}
+		})
+	}
+}
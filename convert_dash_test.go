@@ -0,0 +1,80 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestDashesAndTildesConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without options",
+			input:   "‐‑‒–—―−－ 〜～",
+			options: 0,
+			expect:  "‐‑‒–—―−－ 〜～",
+		},
+		{
+			name:    "Dashes",
+			input:   "‐‑‒–—―−﹘﹣－-",
+			options: kana.NormalizeDashes,
+			expect:  "-----------",
+		},
+		{
+			name:    "Prolonged sound marks between digits",
+			input:   "03ー1234ー5678 〒100ｰ0001 ０３ー１２３４",
+			options: kana.NormalizeDashes,
+			expect:  "03-1234-5678 〒100-0001 ０３-１２３４",
+		},
+		{
+			name:    "Prolonged sound marks not between digits",
+			input:   "ラーメン 3ー ー3 1ーー2 Aー1",
+			options: kana.NormalizeDashes,
+			expect:  "ラーメン 3ー ー3 1ーー2 Aー1",
+		},
+		{
+			name:    "With FullwidthToNarrow",
+			input:   "０３ー１２３４－５６７８",
+			options: kana.NormalizeDashes | kana.FullwidthToNarrow,
+			expect:  "03-1234-5678",
+		},
+		{
+			name:    "With CompatMinus",
+			input:   "―－",
+			options: kana.NormalizeDashes | kana.CompatMinus,
+			expect:  "--",
+		},
+		{
+			name:    "With ExpandProlongedSoundMark",
+			input:   "3ー4 コー",
+			options: kana.NormalizeDashes | kana.ExpandProlongedSoundMark,
+			expect:  "3-4 コオ",
+		},
+		{
+			name:    "Tildes",
+			input:   "⁓∼〜〰～~",
+			options: kana.NormalizeTildes,
+			expect:  "〜〜〜〜〜~",
+		},
+		{
+			name:    "Tildes with FullwidthToNarrow",
+			input:   "⁓∼〜〰～~",
+			options: kana.NormalizeTildes | kana.FullwidthToNarrow | kana.CompatOverline,
+			expect:  "~~~~~~",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestComposeHangulJamoConvert(t *testing.T) {
+	testcases := []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "without option",
+			input:   "ㄱㅏ",
+			options: 0,
+			expect:  "ㄱㅏ",
+		},
+		{
+			name:    "leading and vowel",
+			input:   "ㄱㅏ",
+			options: kana.ComposeHangulJamo,
+			expect:  "가",
+		},
+		{
+			name:    "trailing consonant",
+			input:   "ㅎㅏㄴㄱㅡㄹ",
+			options: kana.ComposeHangulJamo,
+			expect:  "한글",
+		},
+		{
+			name:    "consonant before vowel is leading",
+			input:   "ㄱㅏㄴㅏ",
+			options: kana.ComposeHangulJamo,
+			expect:  "가나",
+		},
+		{
+			name:    "cluster as trailing consonant",
+			input:   "ㄷㅏㄺ ㄱㅏㅄㅅㅣ",
+			options: kana.ComposeHangulJamo,
+			expect:  "닭 값시",
+		},
+		{
+			name:    "compound vowel",
+			input:   "ㄱㅘㅇ",
+			options: kana.ComposeHangulJamo,
+			expect:  "광",
+		},
+		{
+			name:    "trailing consonant before non-jamo",
+			input:   "ㅅㅓㅇ님",
+			options: kana.ComposeHangulJamo,
+			expect:  "성님",
+		},
+		{
+			name:    "lone jamo",
+			input:   "ㅋㅋ ㅏ ㅠㅠ",
+			options: kana.ComposeHangulJamo,
+			expect:  "ㅋㅋ ㅏ ㅠㅠ",
+		},
+		{
+			name:    "cluster is not leading",
+			input:   "ㄳㅏ",
+			options: kana.ComposeHangulJamo,
+			expect:  "ㄳㅏ",
+		},
+		{
+			name:    "double consonant not trailing",
+			input:   "ㄱㅏㄸ",
+			options: kana.ComposeHangulJamo,
+			expect:  "가ㄸ",
+		},
+		{
+			name:    "not attached to precomposed syllable",
+			input:   "가ㄴ",
+			options: kana.ComposeHangulJamo,
+			expect:  "가ㄴ",
+		},
+		{
+			name:    "filler",
+			input:   "ㄱㅤㅏ",
+			options: kana.ComposeHangulJamo,
+			expect:  "ㄱㅤㅏ",
+		},
+		{
+			name:    "halfwidth",
+			input:   "ﾡￂ ￂﾡ",
+			options: kana.ComposeHangulJamo | kana.HalfwidthToWide,
+			expect:  "가 ㅏㄱ",
+		},
+		{
+			name:    "halfwidth without HalfwidthToWide",
+			input:   "ﾡￂ",
+			options: kana.ComposeHangulJamo,
+			expect:  "ﾡￂ",
+		},
+		{
+			name:    "halfwidth with CompatKeepHalfwidthHangul",
+			input:   "ﾡￂ",
+			options: kana.ComposeHangulJamo | kana.HalfwidthToWide | kana.CompatKeepHalfwidthHangul,
+			expect:  "ﾡￂ",
+		},
+		{
+			name:    "mixed width",
+			input:   "ㅎￂﾤ",
+			options: kana.ComposeHangulJamo | kana.HalfwidthToWide,
+			expect:  "한",
+		},
+		{
+			name:    "with ComposeVoicedSoundMarks",
+			input:   "ㄱㅏか゛",
+			options: kana.ComposeHangulJamo | kana.ComposeVoicedSoundMarks,
+			expect:  "가が",
+		},
+		{
+			name:    "sound marks kept without ComposeVoicedSoundMarks",
+			input:   "ㄱㅏか゛",
+			options: kana.ComposeHangulJamo,
+			expect:  "가か゛",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestIterationMarksConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without ExpandIterationMarks",
+			input:   "いすゞ",
+			options: 0,
+			expect:  "いすゞ",
+		},
+		{
+			name:    "Hiragana iteration marks",
+			input:   "いすゞ みすゞ こゝろ",
+			options: kana.ExpandIterationMarks,
+			expect:  "いすず みすず こころ",
+		},
+		{
+			name:    "Katakana iteration marks",
+			input:   "バヽ スヾキ",
+			options: kana.ExpandIterationMarks,
+			expect:  "バハ スズキ",
+		},
+		{
+			name:    "Voiced iteration mark without voiced kana",
+			input:   "あゞ",
+			options: kana.ExpandIterationMarks,
+			expect:  "ああ゙",
+		},
+		{
+			name:    "Consecutive iteration marks",
+			input:   "あゝゝ",
+			options: kana.ExpandIterationMarks,
+			expect:  "あああ",
+		},
+		{
+			name:    "Iteration marks without kana",
+			input:   "ゝ 漢ゝ Aヽ",
+			options: kana.ExpandIterationMarks,
+			expect:  "ゝ 漢ゝ Aヽ",
+		},
+		{
+			name:    "Decomposed kana",
+			input:   "がゝ がゞ すゞ",
+			options: kana.ExpandIterationMarks,
+			expect:  "がか がが すず",
+		},
+		{
+			name:    "With HalfwidthToWide",
+			input:   "ｽﾞヽ",
+			options: kana.ExpandIterationMarks | kana.HalfwidthToWide,
+			expect:  "ズス",
+		},
+		{
+			name:    "With KatakanaToHiragana",
+			input:   "スヾキ",
+			options: kana.ExpandIterationMarks | kana.KatakanaToHiragana,
+			expect:  "すずき",
+		},
+		{
+			name:    "With RomajiToHiragana",
+			input:   "kokoゝ",
+			options: kana.ExpandIterationMarks | kana.RomajiToHiragana,
+			expect:  "こここ",
+		},
+		{
+			name:    "Vertical marks without ExpandVerticalIterationMarks",
+			input:   "いろ〱 時々",
+			options: kana.ExpandIterationMarks,
+			expect:  "いろ〱 時々",
+		},
+		{
+			name:    "Vertical kana repeat marks",
+			input:   "いろ〱 ひろ〲 いろ〳〵 ひろ〴〵",
+			options: kana.ExpandVerticalIterationMarks,
+			expect:  "いろいろ ひろびろ いろいろ ひろびろ",
+		},
+		{
+			name:    "Vertical kana repeat marks without kana",
+			input:   "い〱 〳〵 いろ〳",
+			options: kana.ExpandVerticalIterationMarks,
+			expect:  "い〱 〳〵 いろ〳",
+		},
+		{
+			name:    "Ideographic iteration marks",
+			input:   "時々 人〻 々",
+			options: kana.ExpandKanjiIterationMarks,
+			expect:  "時時 人人 々",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProlongedSoundMarkConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without ExpandProlongedSoundMark",
+			input:   "ラーメン",
+			options: 0,
+			expect:  "ラーメン",
+		},
+		{
+			name:    "Katakana",
+			input:   "ラーメン コーヒー スープ ケーキ ニュース",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "ラアメン コオヒイ スウプ ケエキ ニュウス",
+		},
+		{
+			name:    "Hiragana",
+			input:   "すーじー",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "すうじい",
+		},
+		{
+			name:    "Small kana",
+			input:   "キャー ァー ㇰー",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "キャア ァア ㇰウ",
+		},
+		{
+			name:    "After N and small TU",
+			input:   "ンー んー ッー っー",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "ンン んん ッー っー",
+		},
+		{
+			name:    "Consecutive marks",
+			input:   "ラーー",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "ラアア",
+		},
+		{
+			name:    "After non-kana",
+			input:   "ー Aー 漢ー",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "ー Aー 漢ー",
+		},
+		{
+			name:    "Decomposed kana",
+			input:   "ガー",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "ガア",
+		},
+		{
+			name:    "Halfwidth without HalfwidthToWide",
+			input:   "ﾗｰﾒﾝ",
+			options: kana.ExpandProlongedSoundMark,
+			expect:  "ﾗｰﾒﾝ",
+		},
+		{
+			name:    "With HalfwidthToWide",
+			input:   "ﾗｰﾒﾝ ｶﾞｰ",
+			options: kana.ExpandProlongedSoundMark | kana.HalfwidthToWide,
+			expect:  "ラアメン ガア",
+		},
+		{
+			name:    "With KatakanaToHiragana",
+			input:   "ラーメン",
+			options: kana.ExpandProlongedSoundMark | kana.KatakanaToHiragana,
+			expect:  "らあめん",
+		},
+		{
+			name:    "With RomajiToKatakana",
+			input:   "ra-men",
+			options: kana.ExpandProlongedSoundMark | kana.RomajiToKatakana,
+			expect:  "ラアメン",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
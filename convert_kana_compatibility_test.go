@@ -0,0 +1,92 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestKanaCompatibilityConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without ExpandKanaCompatibility",
+			input:   "ゟ ヿ ㌔ ㋐ 🈀",
+			options: kana.KatakanaToHiragana | kana.HiraganaToKatakana,
+			expect:  "ゟ ヿ ㌔ ㋐ 🈀",
+		},
+		{
+			name:    "Digraphs",
+			input:   "ゟ ヿ",
+			options: kana.ExpandKanaCompatibility,
+			expect:  "より コト",
+		},
+		{
+			name:    "Circled katakana",
+			input:   "㋐㋑㋒ ㋾",
+			options: kana.ExpandKanaCompatibility,
+			expect:  "アイウ ヲ",
+		},
+		{
+			name:    "Squared katakana words",
+			input:   "㌀ ㌔ ㍉ ㌦ ㍗",
+			options: kana.ExpandKanaCompatibility,
+			expect:  "アパート キロ ミリ ドル ワット",
+		},
+		{
+			name:    "Squared kana",
+			input:   "🈀 🈁 🈂",
+			options: kana.ExpandKanaCompatibility,
+			expect:  "ほか ココ サ",
+		},
+		{
+			name:    "With KatakanaToHiragana",
+			input:   "ヿ ㌔ ㋐ ゟ",
+			options: kana.ExpandKanaCompatibility | kana.KatakanaToHiragana,
+			expect:  "こと きろ あ より",
+		},
+		{
+			name:    "With HiraganaToKatakana",
+			input:   "ゟ 🈀 ㌔",
+			options: kana.ExpandKanaCompatibility | kana.HiraganaToKatakana,
+			expect:  "ヨリ ホカ キロ",
+		},
+		{
+			name:    "With ComposeVoicedSoundMarks",
+			input:   "㋕゛",
+			options: kana.ExpandKanaCompatibility | kana.ComposeVoicedSoundMarks,
+			expect:  "ガ",
+		},
+		{
+			name:    "With ExpandIterationMarks",
+			input:   "㋐ゝ ㌔ゝ",
+			options: kana.ExpandKanaCompatibility | kana.ExpandIterationMarks,
+			expect:  "アア キロロ",
+		},
+		{
+			name:    "With ExpandProlongedSoundMark",
+			input:   "㌀ ㍗ー",
+			options: kana.ExpandKanaCompatibility | kana.ExpandProlongedSoundMark,
+			expect:  "アパアト ワットオ",
+		},
+		{
+			name:    "Other enclosed characters",
+			input:   "㋿ ① 🈐",
+			options: kana.ExpandKanaCompatibility,
+			expect:  "㋿ ① 🈐",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
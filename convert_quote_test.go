@@ -0,0 +1,74 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestQuotesConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without NormalizeQuotes",
+			input:   "‘’“”′″",
+			options: 0,
+			expect:  "‘’“”′″",
+		},
+		{
+			name:    "Single quotes",
+			input:   "‘a’ ‚b‛ 5′ ‵ ＇",
+			options: kana.NormalizeQuotes,
+			expect:  "'a' 'b' 5' ' '",
+		},
+		{
+			name:    "Double quotes",
+			input:   "“a” „b‟ 5″ ‶ 〝c〟 〞 ＂",
+			options: kana.NormalizeQuotes,
+			expect:  "\"a\" \"b\" 5\" \" \"c\" \" \"",
+		},
+		{
+			name:    "Corner brackets are kept",
+			input:   "「a」『b』",
+			options: kana.NormalizeQuotes,
+			expect:  "「a」『b』",
+		},
+		{
+			name:    "With CornerBracketsToQuotes",
+			input:   "「a『b』」 ｢c｣",
+			options: kana.NormalizeQuotes | kana.CornerBracketsToQuotes,
+			expect:  "\"a'b'\" \"c\"",
+		},
+		{
+			name:    "CornerBracketsToQuotes without NormalizeQuotes",
+			input:   "「a」",
+			options: kana.CornerBracketsToQuotes,
+			expect:  "「a」",
+		},
+		{
+			name:    "With CompatQuotes",
+			input:   "‘a’ “b” ＂c＂",
+			options: kana.NormalizeQuotes | kana.FullwidthToNarrow | kana.CompatQuotes,
+			expect:  "'a' \"b\" \"c\"",
+		},
+		{
+			name:    "With RomajiToHiragana",
+			input:   "kan‘i kan′i",
+			options: kana.NormalizeQuotes | kana.RomajiToHiragana,
+			expect:  "かんい かんい",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestRomajiConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without RomajiToHiragana",
+			input:   "kyouto",
+			options: 0,
+			expect:  "kyouto",
+		},
+		{
+			name:    "Vowels",
+			input:   "aiueo",
+			options: kana.RomajiToHiragana,
+			expect:  "あいうえお",
+		},
+		{
+			name:    "Basic syllables",
+			input:   "kakikukekosasisusesotatitutetonaninunenohahihuhehomamimumemoyayuyorarirurerowawo",
+			options: kana.RomajiToHiragana,
+			expect:  "かきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわを",
+		},
+		{
+			name:    "Voiced syllables",
+			input:   "gagigugegozazizuzezodadidudedobabibubebopapipupepo",
+			options: kana.RomajiToHiragana,
+			expect:  "がぎぐげござじずぜぞだぢづでどばびぶべぼぱぴぷぺぽ",
+		},
+		{
+			name:    "Hepburn spellings",
+			input:   "shichitsufujajujo",
+			options: kana.RomajiToHiragana,
+			expect:  "しちつふじゃじゅじょ",
+		},
+		{
+			name:    "Yoon",
+			input:   "kyashunyohyumyaryogyojabyupyo",
+			options: kana.RomajiToHiragana,
+			expect:  "きゃしゅにょひゅみゃりょぎょじゃびゅぴょ",
+		},
+		{
+			name:    "Extended syllables",
+			input:   "fafifefothidhutwuvavivuvevowiwekwatsa",
+			options: kana.RomajiToHiragana,
+			expect:  "ふぁふぃふぇふぉてぃでゅとぅゔぁゔぃゔゔぇゔぉうぃうぇくぁつぁ",
+		},
+		{
+			name:    "Small kana",
+			input:   "xaxixuxexolyalyulyoxtultsuxwaxkaxke",
+			options: kana.RomajiToHiragana,
+			expect:  "ぁぃぅぇぉゃゅょっっゎゕゖ",
+		},
+		{
+			name:    "Sokuon",
+			input:   "kittesshimatchappa",
+			options: kana.RomajiToHiragana,
+			expect:  "きってっしまっちゃっぱ",
+		},
+		{
+			name:    "Hatsuon",
+			input:   "shinnjukukan'ikonbanhonya",
+			options: kana.RomajiToHiragana,
+			expect:  "しんじゅくかんいこんばんほにゃ",
+		},
+		{
+			name:    "Hatsuon at the end",
+			input:   "hon",
+			options: kana.RomajiToHiragana,
+			expect:  "ほん",
+		},
+		{
+			name:    "Long vowel",
+			input:   "ra-men",
+			options: kana.RomajiToHiragana,
+			expect:  "らーめん",
+		},
+		{
+			name:    "Uppercase",
+			input:   "KyouTo",
+			options: kana.RomajiToHiragana,
+			expect:  "きょうと",
+		},
+		{
+			name:    "Unconvertible letters",
+			input:   "kyouto q x 123",
+			options: kana.RomajiToHiragana,
+			expect:  "きょうと q x 123",
+		},
+		{
+			name:    "Katakana",
+			input:   "konpyu-ta-",
+			options: kana.RomajiToKatakana,
+			expect:  "コンピューター",
+		},
+		{
+			name:    "Katakana small ka",
+			input:   "xkaxke",
+			options: kana.RomajiToKatakana,
+			expect:  "ヵヶ",
+		},
+		{
+			name:    "Both RomajiToHiragana and RomajiToKatakana",
+			input:   "kana",
+			options: kana.RomajiToHiragana | kana.RomajiToKatakana,
+			expect:  "かな",
+		},
+		{
+			name:    "With HiraganaToKatakana",
+			input:   "kana",
+			options: kana.RomajiToHiragana | kana.HiraganaToKatakana,
+			expect:  "カナ",
+		},
+		{
+			name:    "With FullwidthToNarrow",
+			input:   "ｋｙｏｕｔｏ",
+			options: kana.RomajiToHiragana | kana.FullwidthToNarrow,
+			expect:  "きょうと",
+		},
+		{
+			name:    "Fullwidth without FullwidthToNarrow",
+			input:   "ｋｙｏｕｔｏ",
+			options: kana.RomajiToHiragana,
+			expect:  "ｋｙｏｕｔｏ",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
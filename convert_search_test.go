@@ -0,0 +1,156 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestSearchNormalizeConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without options",
+			input:   "① x² \uF900 ⼀ ㎏ ︵",
+			options: 0,
+			expect:  "① x² \uF900 ⼀ ㎏ ︵",
+		},
+		{
+			name:    "Enclosed alphanumerics",
+			input:   "① ⑳ ⑴ ⒈ ⒜ Ⓐ ⓐ ⓪ ㉑ ㊿ 🄐 🅉",
+			options: kana.NormalizeEnclosedAlphanumerics,
+			expect:  "1 20 (1) 1. (a) A a 0 21 50 (A) Z",
+		},
+		{
+			name:    "Enclosed alphanumerics without decompositions",
+			input:   "⓫ ⓵",
+			options: kana.NormalizeEnclosedAlphanumerics,
+			expect:  "⓫ ⓵",
+		},
+		{
+			name:    "Superscripts",
+			input:   "x² H₂O ⁿ ª ㆒ ™",
+			options: kana.NormalizeSuperscripts,
+			expect:  "x2 H2O n a 一 ™",
+		},
+		{
+			name:    "CJK compatibility ideographs",
+			input:   "\uF900 \uFA0E \U0002F800",
+			options: kana.NormalizeCJKCompatibilityIdeographs,
+			expect:  "\u8C48 \uFA0E \u4E3D",
+		},
+		{
+			name:    "Kangxi radicals",
+			input:   "⼀ ⾦ ⺟",
+			options: kana.NormalizeKangxiRadicals,
+			expect:  "一 金 母",
+		},
+		{
+			name:    "Squared Latin abbreviations",
+			input:   "㎏ ㎡ ㏂ ㍱ ㎍ 🆐",
+			options: kana.NormalizeSquaredLatinAbbreviations,
+			expect:  "kg m2 a.m. hPa μg DJ",
+		},
+		{
+			name:    "Vertical forms",
+			input:   "︵︶ ︐ ﹁﹂ ︙",
+			options: kana.NormalizeVerticalForms,
+			expect:  "（） ， 「」 …",
+		},
+		{
+			name:    "Vertical forms with FullwidthToNarrow",
+			input:   "︵︶ ︐",
+			options: kana.NormalizeVerticalForms | kana.FullwidthToNarrow,
+			expect:  "() ,",
+		},
+		{
+			name:    "SearchNormalize",
+			input:   "ｶﾞｰﾄﾞ ＡＢＣ ① x² 豈 ⼀ ㎏ ︵ ㌔ が",
+			options: kana.SearchNormalize,
+			expect:  "ガード ABC 1 x2 \u8C48 一 kg ( キロ が",
+		},
+		{
+			name:    "With RomajiToHiragana",
+			input:   "ⓚⓐ ᵏᵃ",
+			options: kana.NormalizeEnclosedAlphanumerics | kana.NormalizeSuperscripts | kana.RomajiToHiragana,
+			expect:  "か か",
+		},
+		{
+			name:    "With ExpandKanjiIterationMarks",
+			input:   "⼀々 ㆒々",
+			options: kana.NormalizeKangxiRadicals | kana.NormalizeSuperscripts | kana.ExpandKanjiIterationMarks,
+			expect:  "一一 一一",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSearchNormalizeNFKC checks that the normalization options
+// agree with NFKC for the characters they change.
+func TestSearchNormalizeNFKC(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		ranges  [][2]rune
+		options kana.ConvertOptions
+	}{
+		{
+			name:    "NormalizeEnclosedAlphanumerics",
+			ranges:  [][2]rune{{0x2460, 0x24FF}, {0x3250, 0x32BF}, {0x1F100, 0x1F1FF}},
+			options: kana.NormalizeEnclosedAlphanumerics,
+		},
+		{
+			name:    "NormalizeSuperscripts",
+			ranges:  [][2]rune{{0x0080, 0x33FF}, {0xA600, 0xABFF}, {0x10700, 0x107FF}},
+			options: kana.NormalizeSuperscripts,
+		},
+		{
+			name:    "NormalizeCJKCompatibilityIdeographs",
+			ranges:  [][2]rune{{0xF900, 0xFAFF}, {0x2F800, 0x2FA1F}},
+			options: kana.NormalizeCJKCompatibilityIdeographs,
+		},
+		{
+			name:    "NormalizeKangxiRadicals",
+			ranges:  [][2]rune{{0x2E80, 0x2FDF}},
+			options: kana.NormalizeKangxiRadicals,
+		},
+		{
+			name:    "NormalizeSquaredLatinAbbreviations",
+			ranges:  [][2]rune{{0x3250, 0x33FF}, {0x1F100, 0x1F1FF}},
+			options: kana.NormalizeSquaredLatinAbbreviations,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, r := range tc.ranges {
+				for ch := r[0]; ch <= r[1]; ch++ {
+					s := string(ch)
+					actual := kana.Convert(s, tc.options)
+					if actual == s {
+						continue
+					}
+					expect := norm.NFKC.String(s)
+					if expect == s {
+						// Unknown to the Unicode version of golang.org/x/text
+						continue
+					}
+					if actual != expect {
+						t.Errorf("%U: expected %q, got %q", ch, expect, actual)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestSmallKanaConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without SmallKanaToLarge",
+			input:   "キャッシュ",
+			options: 0,
+			expect:  "キャッシュ",
+		},
+		{
+			name:    "Hiragana",
+			input:   "ぁぃぅぇぉっゃゅょゎゕゖ",
+			options: kana.SmallKanaToLarge,
+			expect:  "あいうえおつやゆよわかけ",
+		},
+		{
+			name:    "Katakana",
+			input:   "ァィゥェォッャュョヮヵヶ",
+			options: kana.SmallKanaToLarge,
+			expect:  "アイウエオツヤユヨワカケ",
+		},
+		{
+			name:    "Katakana Phonetic Extensions",
+			input:   "ㇰㇱㇲㇳㇴㇵㇶㇷㇸㇹㇺㇻㇼㇽㇾㇿ",
+			options: kana.SmallKanaToLarge,
+			expect:  "クシストヌハヒフヘホムラリルレロ",
+		},
+		{
+			name:    "Small Kana Extension",
+			input:   "\U0001B132\U0001B150\U0001B151\U0001B152\U0001B155\U0001B164\U0001B165\U0001B166\U0001B167",
+			options: kana.SmallKanaToLarge,
+			expect:  "こゐゑをコヰヱヲン",
+		},
+		{
+			name:    "Halfwidth katakana",
+			input:   "ｧｨｩｪｫｬｭｮｯ",
+			options: kana.SmallKanaToLarge,
+			expect:  "ｱｲｳｴｵﾔﾕﾖﾂ",
+		},
+		{
+			name:    "Halfwidth katakana with HalfwidthToWide",
+			input:   "ｼﾞｪｰﾑｽﾞ",
+			options: kana.SmallKanaToLarge | kana.HalfwidthToWide,
+			expect:  "ジエームズ",
+		},
+		{
+			name:    "Large kana",
+			input:   "キヤ",
+			options: kana.SmallKanaToLarge,
+			expect:  "キヤ",
+		},
+		{
+			name:    "With KatakanaToHiragana",
+			input:   "キャッ\U0001B155",
+			options: kana.SmallKanaToLarge | kana.KatakanaToHiragana,
+			expect:  "きやつこ",
+		},
+		{
+			name:    "With HiraganaToKatakana",
+			input:   "きゃっゕ",
+			options: kana.SmallKanaToLarge | kana.HiraganaToKatakana,
+			expect:  "キヤツカ",
+		},
+		{
+			name:    "With CompatWideKatakanaToHalfwidth",
+			input:   "キャ",
+			options: kana.SmallKanaToLarge | kana.CompatWideKatakanaToHalfwidth,
+			expect:  "ｷﾔ",
+		},
+		{
+			name:    "With RomajiToHiragana",
+			input:   "kyaxtu",
+			options: kana.SmallKanaToLarge | kana.RomajiToHiragana,
+			expect:  "きやつ",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package kana_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestStripVoicedSoundMarksConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without StripVoicedSoundMarks",
+			input:   "バパ",
+			options: 0,
+			expect:  "バパ",
+		},
+		{
+			name:    "Hiragana",
+			input:   "がぎぐげござじずぜぞだぢづでどばびぶべぼぱぴぷぺぽゔゞ",
+			options: kana.StripVoicedSoundMarks,
+			expect:  "かきくけこさしすせそたちつてとはひふへほはひふへほうゝ",
+		},
+		{
+			name:    "Katakana",
+			input:   "ガギグゲゴザジズゼゾダヂヅデドバビブベボパピプペポヴヷヸヹヺヾ",
+			options: kana.StripVoicedSoundMarks,
+			expect:  "カキクケコサシスセソタチツテトハヒフヘホハヒフヘホウワヰヱヲヽ",
+		},
+		{
+			name:    "Sound marks",
+			input:   "ガパカ゛ハ゜",
+			options: kana.StripVoicedSoundMarks,
+			expect:  "カハカハ",
+		},
+		{
+			name:    "Halfwidth sound marks",
+			input:   "ｶﾞﾊﾟﾞ",
+			options: kana.StripVoicedSoundMarks,
+			expect:  "ｶﾊ",
+		},
+		{
+			name:    "With HalfwidthToWide",
+			input:   "ﾊﾞﾊﾟﾊｳﾞﾞ",
+			options: kana.StripVoicedSoundMarks | kana.HalfwidthToWide,
+			expect:  "ハハハウ",
+		},
+		{
+			name:    "Only sound marks",
+			input:   "゛゛゛",
+			options: kana.StripVoicedSoundMarks,
+			expect:  "",
+		},
+		{
+			name:    "Many sound marks",
+			input:   strings.Repeat("゛", 200) + "カ",
+			options: kana.StripVoicedSoundMarks,
+			expect:  "カ",
+		},
+		{
+			name:    "With KatakanaToHiragana",
+			input:   "ヴァイオリン ヷ",
+			options: kana.StripVoicedSoundMarks | kana.KatakanaToHiragana,
+			expect:  "うぁいおりん わ",
+		},
+		{
+			name:    "With CompatWideKatakanaToHalfwidth",
+			input:   "バパ",
+			options: kana.StripVoicedSoundMarks | kana.CompatWideKatakanaToHalfwidth,
+			expect:  "ﾊﾊ",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComposeVoicedSoundMarksConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "Without ComposeVoicedSoundMarks",
+			input:   "か゛がハ゜",
+			options: 0,
+			expect:  "か゛がハ゜",
+		},
+		{
+			name:    "Spacing sound marks",
+			input:   "か゛ハ゜ヒ゛",
+			options: kana.ComposeVoicedSoundMarks,
+			expect:  "がパビ",
+		},
+		{
+			name:    "Combining sound marks",
+			input:   "がパゔヴ",
+			options: kana.ComposeVoicedSoundMarks,
+			expect:  "がパゔヴ",
+		},
+		{
+			name:    "Katakana VA",
+			input:   "ヷヸヹヺ",
+			options: kana.ComposeVoicedSoundMarks,
+			expect:  "ヷヸヹヺ",
+		},
+		{
+			name:    "Iteration marks",
+			input:   "ゝ゛ヽ゛",
+			options: kana.ComposeVoicedSoundMarks,
+			expect:  "ゞヾ",
+		},
+		{
+			name:    "Without precomposed kana",
+			input:   "ア゛か゜゛",
+			options: kana.ComposeVoicedSoundMarks,
+			expect:  "ア゙か゚゙",
+		},
+		{
+			name:    "Voiced kana",
+			input:   "が゛",
+			options: kana.ComposeVoicedSoundMarks,
+			expect:  "が゙",
+		},
+		{
+			name:    "With HalfwidthToWide",
+			input:   "ｳ゙ｶﾞﾊ゜ﾜﾞ",
+			options: kana.ComposeVoicedSoundMarks | kana.HalfwidthToWide,
+			expect:  "ヴガパヷ",
+		},
+		{
+			name:    "With HalfwidthToWide and compat",
+			input:   "ﾜﾞｱﾞ",
+			options: kana.ComposeVoicedSoundMarks | kana.HalfwidthToWide | kana.CompatVoicedSoundMarks | kana.CompatVoicedKanaRestriction,
+			expect:  "ヷア゙",
+		},
+		{
+			name:    "With KatakanaToHiragana",
+			input:   "カ゛ヷ",
+			options: kana.ComposeVoicedSoundMarks | kana.KatakanaToHiragana,
+			expect:  "がわ゙",
+		},
+		{
+			name:    "With StripVoicedSoundMarks",
+			input:   "カ゛",
+			options: kana.ComposeVoicedSoundMarks | kana.StripVoicedSoundMarks,
+			expect:  "カ",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
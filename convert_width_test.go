@@ -0,0 +1,272 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestFullwidthClassesConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "All classes",
+			input:   "ＡＢＣ１２３　！？＋＄、。",
+			options: kana.FullwidthToNarrow,
+			expect:  "ABC123 !?+$、。",
+		},
+		{
+			name:    "Alphanumerics",
+			input:   "ＡＢＣ１２３　！？＋＄、。",
+			options: kana.FullwidthAlnumToNarrow,
+			expect:  "ABC123　！？＋＄、。",
+		},
+		{
+			name:    "Symbols",
+			input:   "ＡＢＣ１２３　！？＋＄￥～、。",
+			options: kana.FullwidthSymbolsToNarrow,
+			expect:  "ＡＢＣ１２３　！？+$¥~、。",
+		},
+		{
+			name:    "Punctuation",
+			input:   "ＡＢＣ１２３　！？（）＋＄、。",
+			options: kana.FullwidthPunctuationToNarrow,
+			expect:  "ＡＢＣ１２３　!?()＋＄、。",
+		},
+		{
+			name:    "Space",
+			input:   "ＡＢＣ１２３　！？＋＄、。",
+			options: kana.FullwidthSpaceToNarrow,
+			expect:  "ＡＢＣ１２３ ！？＋＄、。",
+		},
+		{
+			name:    "Alphanumerics and space",
+			input:   "ＡＢＣ　１２３！",
+			options: kana.FullwidthAlnumToNarrow | kana.FullwidthSpaceToNarrow,
+			expect:  "ABC 123！",
+		},
+		{
+			name:    "Space with CompatDoubleSpaces",
+			input:   "Ａ　Ｂ",
+			options: kana.FullwidthSpaceToNarrow | kana.CompatDoubleSpaces,
+			expect:  "Ａ  Ｂ",
+		},
+		{
+			name:    "CompatDoubleSpaces without FullwidthSpaceToNarrow",
+			input:   "Ａ　Ｂ",
+			options: kana.FullwidthAlnumToNarrow | kana.CompatDoubleSpaces,
+			expect:  "A　B",
+		},
+		{
+			name:    "Punctuation with CompatMinus",
+			input:   "—−",
+			options: kana.FullwidthPunctuationToNarrow | kana.CompatMinus,
+			expect:  "-−",
+		},
+		{
+			name:    "Punctuation with CompatBrackets",
+			input:   "〈ａ〉",
+			options: kana.FullwidthPunctuationToNarrow | kana.CompatBrackets,
+			expect:  "<ａ>",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNarrowToFullwidthConvert(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "All classes",
+			input:   "ABC 123!?+$~ ¢£¬¯¦¥₩ ⦅⦆",
+			options: kana.NarrowToFullwidth,
+			expect:  "ＡＢＣ　１２３！？＋＄～　￠￡￢￣￤￥￦　｟｠",
+		},
+		{
+			name:    "Alphanumerics",
+			input:   "ABC 123!?+$",
+			options: kana.NarrowAlnumToFullwidth,
+			expect:  "ＡＢＣ １２３!?+$",
+		},
+		{
+			name:    "Symbols",
+			input:   "ABC 123!?+$¥",
+			options: kana.NarrowSymbolsToFullwidth,
+			expect:  "ABC 123!?＋＄￥",
+		},
+		{
+			name:    "Punctuation",
+			input:   "ABC 123!?+$",
+			options: kana.NarrowPunctuationToFullwidth,
+			expect:  "ABC 123！？+$",
+		},
+		{
+			name:    "Space",
+			input:   "ABC 123!?+$",
+			options: kana.NarrowSpaceToFullwidth,
+			expect:  "ABC　123!?+$",
+		},
+		{
+			name:    "Round trip",
+			input:   "ＡＢＣ　１２３！？＋＄～￠￡￢￣￤￥￦｟｠",
+			options: kana.FullwidthToNarrow,
+			expect:  "ABC 123!?+$~¢£¬¯¦¥₩⦅⦆",
+		},
+		{
+			name:    "Swapping classes",
+			input:   "ＡＢＣ+$ 123＋＄",
+			options: kana.FullwidthAlnumToNarrow | kana.NarrowSymbolsToFullwidth,
+			expect:  "ABC＋＄ 123＋＄",
+		},
+		{
+			name:    "With FullwidthToNarrow",
+			input:   "ＡＢＣ ABC",
+			options: kana.FullwidthToNarrow | kana.NarrowToFullwidth,
+			expect:  "ABC ABC",
+		},
+		{
+			name:    "With RomajiToHiragana",
+			input:   "kyouto 2024 ra-men",
+			options: kana.NarrowToFullwidth | kana.RomajiToHiragana,
+			expect:  "きょうと　２０２４　らーめん",
+		},
+		{
+			name:    "With NormalizeDashes",
+			input:   "03ー1234−5678",
+			options: kana.NarrowToFullwidth | kana.NormalizeDashes,
+			expect:  "０３－１２３４－５６７８",
+		},
+		{
+			name:    "With HalfwidthToWide",
+			input:   "ｶﾅ ABC",
+			options: kana.NarrowToFullwidth | kana.HalfwidthToWide,
+			expect:  "カナ　ＡＢＣ",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWideKatakanaToHalfwidthConvert(t *testing.T) {
+	testcases := []struct {
+		name    string
+		input   string
+		options kana.ConvertOptions
+		expect  string
+	}{
+		{
+			name:    "letters",
+			input:   "カタカナ・テキスト",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "ｶﾀｶﾅ･ﾃｷｽﾄ",
+		},
+		{
+			name:    "voiced and semi-voiced",
+			input:   "ガパヴヷヺ",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "ｶﾞﾊﾟｳﾞﾜﾞｦﾞ",
+		},
+		{
+			name:    "decomposed",
+			input:   "カ\u3099ハ\u309Aワ\u3099ヲ\u3099",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "ｶﾞﾊﾟﾜﾞｦﾞ",
+		},
+		{
+			name:    "combining marks after halfwidth katakana",
+			input:   "ｶ\u3099ﾊ\u309A",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "ｶﾞﾊﾟ",
+		},
+		{
+			name:    "combining marks after other characters",
+			input:   "あ\u3099ヰ\u3099",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "あ\u3099ヰ\u3099",
+		},
+		{
+			name:    "spacing marks",
+			input:   "カ゛ハ゜",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "ｶﾞﾊﾟ",
+		},
+		{
+			name:    "prolonged sound mark",
+			input:   "ラーメン",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "ﾗｰﾒﾝ",
+		},
+		{
+			name:    "letters without halfwidth forms",
+			input:   "ヮヰヱヵヶヽヾ",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "ヮヰヱヵヶヽヾ",
+		},
+		{
+			name:    "punctuation kept",
+			input:   "「カナ」、。",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "「ｶﾅ」、。",
+		},
+		{
+			name:    "hiragana kept",
+			input:   "がっこう",
+			options: kana.WideKatakanaToHalfwidth,
+			expect:  "がっこう",
+		},
+		{
+			name:    "with HiraganaToKatakana",
+			input:   "がっこう",
+			options: kana.WideKatakanaToHalfwidth | kana.HiraganaToKatakana,
+			expect:  "ｶﾞｯｺｳ",
+		},
+		{
+			name:    "with RomajiToKatakana",
+			input:   "ka\u3099na",
+			options: kana.WideKatakanaToHalfwidth | kana.RomajiToKatakana,
+			expect:  "ｶﾞﾅ",
+		},
+		{
+			name:    "with ExpandIterationMarks",
+			input:   "カヽヾ",
+			options: kana.WideKatakanaToHalfwidth | kana.ExpandIterationMarks,
+			expect:  "ｶｶｶﾞ",
+		},
+		{
+			name:    "with CompatWideKatakanaToHalfwidth",
+			input:   "「ヷ」",
+			options: kana.WideKatakanaToHalfwidth | kana.CompatWideKatakanaToHalfwidth,
+			expect:  "｢ﾜﾞ｣",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.Convert(tc.input, tc.options)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package kana
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// Converter converts strings with a fixed set of options.
+//
+// Converting with a Converter gives the same result as [Convert],
+// but it is faster when the same options are used many times
+// because the conversion rules are flattened into precomputed tables
+// when the Converter is created.
+//
+// A Converter is safe for concurrent use by multiple goroutines.
+type Converter struct {
+	opts ConvertOptions
+	// U+0000 to U+007F (Basic Latin)
+	basicLatin [0x80]tableEntry
+	// U+3000 to U+30FF (CJK Symbols and Punctuation, Hiragana, and Katakana)
+	kana [0x100]tableEntry
+	// U+FF00 to U+FFEF (Halfwidth and Fullwidth Forms)
+	halfwidthAndFullwidth [0xF0]tableEntry
+	// pipelines holds the pipelines for the characters
+	// not covered by the tables.
+	pipelines sync.Pool
+}
+
+type tableEntry struct {
+	// out is the conversion result of the character alone.
+	out string
+	// keep is true if the character is converted to itself.
+	keep bool
+	// contextual is true if the conversion may depend on
+	// the following characters, in which case out can be used
+	// only if the character does not join with the next one.
+	contextual bool
+}
+
+// NewConverter returns a [Converter] that converts strings with the given options.
+func NewConverter(opts ConvertOptions) *Converter {
+	c := &Converter{opts: opts.Normalize()}
+	for i := range c.basicLatin {
+		c.basicLatin[i] = c.newTableEntry(rune(i))
+	}
+	for i := range c.kana {
+		c.kana[i] = c.newTableEntry('\u3000' + rune(i))
+	}
+	for i := range c.halfwidthAndFullwidth {
+		c.halfwidthAndFullwidth[i] = c.newTableEntry('\uFF00' + rune(i))
+	}
+	return c
+}
+
+func (c *Converter) newTableEntry(ch rune) tableEntry {
+	str := string(ch)
+	out := convertStream(inputString(str).stream(0, len(str)), c.opts).readAll(len(str))
+	return tableEntry{
+		out:        out,
+		keep:       out == str,
+		contextual: mayJoinNext(ch, c.opts),
+	}
+}
+
+func (c *Converter) lookup(ch rune) *tableEntry {
+	switch {
+	case ch < 0x80:
+		return &c.basicLatin[ch]
+	case '\u3000' <= ch && ch <= '\u30FF':
+		return &c.kana[ch-'\u3000']
+	case '\uFF00' <= ch && ch <= '\uFFEF':
+		return &c.halfwidthAndFullwidth[ch-'\uFF00']
+	}
+	return nil
+}
+
+// Options returns the normalized options of the Converter.
+func (c *Converter) Options() ConvertOptions {
+	return c.opts
+}
+
+// Convert converts a string.
+//
+// If no character in the input is changed by the conversion,
+// the input is returned as is without allocation.
+func (c *Converter) Convert(input string) string {
+	in := inputString(input)
+	if c.keptSpan(in) == len(input) {
+		return input
+	}
+	return string(c.appendConvert(make([]byte, 0, len(input)), in))
+}
+
+// AppendConvert converts a string and appends the result to dst,
+// returning the extended buffer.
+func (c *Converter) AppendConvert(dst []byte, input string) []byte {
+	return c.appendConvert(dst, inputString(input))
+}
+
+// ConvertBytes converts a UTF-8 encoded byte slice and appends the result
+// to dst, returning the extended buffer.
+//
+// src and dst must not overlap.
+func (c *Converter) ConvertBytes(dst, src []byte) []byte {
+	return c.appendConvert(dst, inputBytes(src))
+}
+
+// keptSpan returns the length of the longest prefix of in
+// that is kept as is by the conversion.
+func (c *Converter) keptSpan(in input) int {
+	p := 0
+	for p < in.len() {
+		ch, size := in.decodeRune(p)
+		if e := c.lookup(ch); e != nil {
+			if !e.keep || e.contextual {
+				break
+			}
+		} else if ch == utf8.RuneError && size == 1 || mayChange(ch, c.opts) {
+			break
+		}
+		p += size
+	}
+	return p
+}
+
+func (c *Converter) appendConvert(dst []byte, in input) []byte {
+	var pl *pipeline
+	for p := 0; p < in.len(); {
+		ch, size := in.decodeRune(p)
+		if e := c.lookup(ch); e != nil && !(e.contextual && c.joinsNext(in, p+size, ch)) {
+			if e.keep {
+				dst = in.appendSlice(dst, p, p+size)
+			} else {
+				dst = append(dst, e.out...)
+			}
+			p += size
+			continue
+		} else if e == nil && !mayChange(ch, c.opts) {
+			if ch == utf8.RuneError && size == 1 {
+				// Invalid UTF-8
+				dst = appendRune(dst, ch)
+			} else {
+				dst = in.appendSlice(dst, p, p+size)
+			}
+			p += size
+			continue
+		}
+
+		n, _ := nextSegment(in, p, true, c.opts)
+		if pl == nil {
+			pl = getPipeline(&c.pipelines, in, c.opts)
+		}
+		dst = pl.appendRange(dst, p, p+n)
+		p += n
+	}
+	if pl != nil {
+		pl.release()
+	}
+	return dst
+}
+
+func (c *Converter) joinsNext(in input, p int, ch rune) bool {
+	if p >= in.len() {
+		return false
+	}
+	next, _ := in.decodeRune(p)
+	return joins(ch, next, c.opts)
+}
@@ -0,0 +1,143 @@
+package kana_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+// converterTestInput contains every character in the tables of Converter
+// and some characters outside of them.
+func converterTestInput() string {
+	var b strings.Builder
+	for ch := rune(0); ch < 0x80; ch++ {
+		b.WriteRune(ch)
+	}
+	for ch := '　'; ch <= 'ヿ'; ch++ {
+		b.WriteRune(ch)
+	}
+	for ch := '＀'; ch <= '￯'; ch++ {
+		b.WriteRune(ch)
+	}
+	b.WriteString("ｶﾞｷﾞﾊﾟﾋﾟｳﾞﾜﾞｦﾞﾞﾟ")
+	b.WriteString(" kyouto shinnjuku ra-men kan'i hon")
+	b.WriteString("´‘’“”—―−∥漢字 時々 いすゞ いろ〱 ひろ〴〵 ㌔ ㋕゛ゟ🈀 ①ⓚⓐ ㎏ x² ︵⼀々︶ 03ー1234ー5678 〜～ 「『′″』」 ㅎㅏㄴㄱㅡㄹ ㄱㅏㄴㅏ")
+	b.WriteString("\U0001B132\U0001B150\U0001B151\U0001B152\U0001B155\U0001B164\U0001B165\U0001B166")
+	b.WriteString("\xE3\x82\xFF")
+	return b.String()
+}
+
+var converterTestOptions = []kana.ConvertOptions{
+	0,
+	kana.HalfwidthToWide,
+	kana.FullwidthToNarrow,
+	kana.KatakanaToHiragana,
+	kana.HiraganaToKatakana,
+	kana.CompatWideKatakanaToHalfwidth,
+	kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana,
+	kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.HiraganaToKatakana,
+	kana.HalfwidthToWide | kana.CompatVoicedSoundMarks | kana.CompatVoicedKanaRestriction | kana.CompatKeepHalfwidthHangul | kana.CompatKeepHalfwidthSymbols,
+	kana.FullwidthToNarrow | kana.CompatQuotes | kana.CompatMinus | kana.CompatOverline | kana.CompatCurrency | kana.CompatBrackets | kana.CompatOtherSymbols | kana.CompatDoubleSpaces,
+	kana.CompatMinus | kana.CompatOverline | kana.CompatCurrency | kana.CompatOtherSymbols,
+	kana.KatakanaToHiragana | kana.HiraganaToKatakana | kana.CompatKanaRestriction,
+	kana.RomajiToHiragana | kana.FullwidthToNarrow,
+	kana.RomajiToKatakana | kana.KatakanaToHiragana,
+	kana.SmallKanaToLarge | kana.HalfwidthToWide | kana.HiraganaToKatakana,
+	kana.StripVoicedSoundMarks,
+	kana.StripVoicedSoundMarks | kana.HalfwidthToWide | kana.KatakanaToHiragana,
+	kana.ComposeVoicedSoundMarks,
+	kana.ComposeVoicedSoundMarks | kana.HalfwidthToWide | kana.HiraganaToKatakana,
+	kana.ExpandIterationMarks | kana.ExpandVerticalIterationMarks | kana.ExpandKanjiIterationMarks,
+	kana.ExpandIterationMarks | kana.HalfwidthToWide | kana.KatakanaToHiragana,
+	kana.ExpandProlongedSoundMark | kana.HalfwidthToWide,
+	kana.ExpandKanaCompatibility | kana.KatakanaToHiragana,
+	kana.SearchNormalize,
+	kana.SearchNormalize | kana.RomajiToHiragana,
+	kana.NormalizeDashes | kana.NormalizeTildes,
+	kana.NormalizeQuotes | kana.CornerBracketsToQuotes,
+	kana.FullwidthAlnumToNarrow | kana.FullwidthSpaceToNarrow,
+	kana.FullwidthPunctuationToNarrow | kana.CompatQuotes,
+	kana.NarrowToFullwidth,
+	kana.NarrowAlnumToFullwidth | kana.RomajiToKatakana,
+	kana.ComposeHangulJamo | kana.HalfwidthToWide,
+	kana.WideKatakanaToHalfwidth | kana.HiraganaToKatakana,
+	kana.WideKatakanaToHalfwidth | kana.HalfwidthToWide | kana.ComposeVoicedSoundMarks,
+}
+
+func TestConverter(t *testing.T) {
+	input := converterTestInput()
+	for _, opts := range converterTestOptions {
+		t.Run(opts.String(), func(t *testing.T) {
+			expect := kana.Convert(input, opts)
+			c := kana.NewConverter(opts)
+
+			if diff := cmp.Diff(expect, c.Convert(input)); diff != "" {
+				t.Errorf("unexpected diff in Convert (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff("prefix:"+expect, string(c.ConvertBytes([]byte("prefix:"), []byte(input)))); diff != "" {
+				t.Errorf("unexpected diff in ConvertBytes (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff("prefix:"+expect, string(c.AppendConvert([]byte("prefix:"), input))); diff != "" {
+				t.Errorf("unexpected diff in AppendConvert (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConverterTransformTestcases(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := kana.Convert(tc.input, tc.options)
+			actual := kana.NewConverter(tc.options).Convert(tc.input)
+			if diff := cmp.Diff(expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConverterConcurrent(t *testing.T) {
+	input := converterTestInput()
+	opts := kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana
+	expect := kana.Convert(input, opts)
+	c := kana.NewConverter(opts)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if actual := c.Convert(input); actual != expect {
+					t.Errorf("unexpected result: %q", actual)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkConverter(b *testing.B) {
+	input := strings.Repeat("ｶﾀｶﾅとＡＢＣと漢字", 100)
+	opts := kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.KatakanaToHiragana
+
+	b.Run("Convert", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(input)))
+		for i := 0; i < b.N; i++ {
+			kana.Convert(input, opts)
+		}
+	})
+	b.Run("Converter", func(b *testing.B) {
+		c := kana.NewConverter(opts)
+		b.ReportAllocs()
+		b.SetBytes(int64(len(input)))
+		for i := 0; i < b.N; i++ {
+			c.Convert(input)
+		}
+	})
+}
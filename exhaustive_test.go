@@ -0,0 +1,8 @@
+//go:build exhaustive
+// +build exhaustive
+
+package kana
+
+// exhaustiveTest is true if the expensive tests check
+// all the characters rather than a sample of them.
+const exhaustiveTest = true
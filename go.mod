@@ -2,4 +2,7 @@ module github.com/wantedly/kana-go
 
 go 1.11
 
-require github.com/google/go-cmp v0.6.0
+require (
+	github.com/google/go-cmp v0.6.0
+	golang.org/x/text v0.3.8
+)
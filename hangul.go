@@ -0,0 +1,102 @@
+package kana
+
+import "strings"
+
+const (
+	hangulSyllableBase = '가'
+	hangulVowelCount   = 21
+	hangulTrailCount   = 28
+)
+
+// hangulLeadingJamo lists the compatibility jamo that can be
+// a leading consonant (choseong), in the order of the syllable table.
+const hangulLeadingJamo = "ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ"
+
+// hangulTrailingJamo lists the compatibility jamo that can be
+// a trailing consonant (jongseong), in the order of the syllable table.
+// The index in the table is one-based, as zero means no trailing consonant.
+const hangulTrailingJamo = "ㄱㄲㄳㄴㄵㄶㄷㄹㄺㄻㄼㄽㄾㄿㅀㅁㅂㅄㅅㅆㅇㅈㅊㅋㅌㅍㅎ"
+
+// composeHangulJamo appends the Hangul syllable composed of ch and
+// the following compatibility jamo if ch starts a syllable.
+// It reports whether it has appended anything.
+//
+// A syllable is composed only if ch is a leading consonant
+// directly followed by a vowel.
+// The consonant after the vowel is taken as the trailing consonant
+// unless it is followed by another vowel, in which case
+// it is the leading consonant of the next syllable.
+// Other jamo, such as lone vowels and consonant clusters
+// at the start of a syllable, are kept as is.
+func composeHangulJamo(ch rune, strm *stream, buf *[]rune, opts ConvertOptions) bool {
+	if opts&ComposeHangulJamo == 0 {
+		return false
+	}
+	l := hangulLeadingIndex(ch)
+	if l < 0 {
+		return false
+	}
+	ahead := strm.peek(3)
+	if len(ahead) == 0 || !isHangulVowelJamo(ahead[0]) {
+		return false
+	}
+	syllable := hangulSyllableBase + (rune(l)*hangulVowelCount+ahead[0]-'ㅏ')*hangulTrailCount
+	n := 1
+	if len(ahead) >= 2 {
+		if t := hangulTrailingIndex(ahead[1]); t > 0 && (len(ahead) < 3 || !isHangulVowelJamo(ahead[2])) {
+			syllable += rune(t)
+			n++
+		}
+	}
+	strm.consume(n)
+	*buf = append(*buf, syllable)
+	return true
+}
+
+// hangulLeadingIndex returns the index of ch as a leading consonant,
+// or -1 if ch cannot be a leading consonant.
+func hangulLeadingIndex(ch rune) int {
+	if !isHangulConsonantJamo(ch) {
+		return -1
+	}
+	i := strings.IndexRune(hangulLeadingJamo, ch)
+	if i < 0 {
+		return -1
+	}
+	// All the jamo are three bytes long in UTF-8
+	return i / 3
+}
+
+// hangulTrailingIndex returns the one-based index of ch
+// as a trailing consonant, or 0 if ch cannot be a trailing consonant.
+func hangulTrailingIndex(ch rune) int {
+	if !isHangulConsonantJamo(ch) {
+		return 0
+	}
+	i := strings.IndexRune(hangulTrailingJamo, ch)
+	if i < 0 {
+		return 0
+	}
+	return i/3 + 1
+}
+
+// isHangulConsonantJamo reports whether ch is
+// a modern Hangul compatibility consonant (ㄱ to ㅎ).
+func isHangulConsonantJamo(ch rune) bool {
+	return 'ㄱ' <= ch && ch <= 'ㅎ'
+}
+
+// isHangulVowelJamo reports whether ch is
+// a modern Hangul compatibility vowel (ㅏ to ㅣ).
+func isHangulVowelJamo(ch rune) bool {
+	return 'ㅏ' <= ch && ch <= 'ㅣ'
+}
+
+// mayBeHangulJamo reports whether ch may be a modern Hangul
+// compatibility jamo after the width conversion.
+func mayBeHangulJamo(ch rune, opts ConvertOptions) bool {
+	if 'ㄱ' <= ch && ch <= 'ㅣ' {
+		return true
+	}
+	return opts&HalfwidthToWide != 0 && 'ﾡ' <= ch && ch <= 'ￜ'
+}
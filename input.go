@@ -0,0 +1,58 @@
+package kana
+
+import "unicode/utf8"
+
+// input abstracts over string and []byte sources
+// so that the same code can process both without copying.
+// If bytes is nil, str is used.
+type input struct {
+	str   string
+	bytes []byte
+}
+
+func inputString(s string) input {
+	return input{str: s}
+}
+
+func inputBytes(b []byte) input {
+	return input{bytes: b}
+}
+
+func (in input) len() int {
+	if in.bytes == nil {
+		return len(in.str)
+	}
+	return len(in.bytes)
+}
+
+func (in input) at(p int) byte {
+	if in.bytes == nil {
+		return in.str[p]
+	}
+	return in.bytes[p]
+}
+
+func (in input) decodeRune(p int) (rune, int) {
+	if in.bytes == nil {
+		return utf8.DecodeRuneInString(in.str[p:])
+	}
+	return utf8.DecodeRune(in.bytes[p:])
+}
+
+func (in input) fullRune(p int) bool {
+	if in.bytes == nil {
+		return utf8.FullRuneInString(in.str[p:])
+	}
+	return utf8.FullRune(in.bytes[p:])
+}
+
+func (in input) appendSlice(dst []byte, b, e int) []byte {
+	if in.bytes == nil {
+		return append(dst, in.str[b:e]...)
+	}
+	return append(dst, in.bytes[b:e]...)
+}
+
+func (in input) stream(b, e int) *stream {
+	return inputStream(&in, &b, &e)
+}
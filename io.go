@@ -0,0 +1,34 @@
+package kana
+
+import (
+	"io"
+
+	"golang.org/x/text/transform"
+)
+
+// NewReader returns a reader that converts the text read from r
+// with the given options, in the same way as [Convert] does.
+//
+// The conversion is done incrementally with a fixed-size buffer,
+// so the reader can be used for arbitrarily large inputs.
+// Invalid UTF-8 sequences are replaced with U+FFFD REPLACEMENT CHARACTER,
+// even if they are split across reads.
+//
+// See [NewTransformer] for the limit of the lookahead.
+func NewReader(r io.Reader, opts ConvertOptions) io.Reader {
+	return transform.NewReader(r, NewTransformer(opts))
+}
+
+// NewWriter returns a writer that converts the text written to it
+// with the given options, in the same way as [Convert] does,
+// and writes the result to w.
+//
+// The writer may hold back the last few bytes written to it
+// until it sees the following character (e.g. a halfwidth katakana
+// that may be followed by a halfwidth voiced sound mark).
+// Close must be called to flush them. Close does not close w.
+//
+// See [NewTransformer] for the limit of the lookahead.
+func NewWriter(w io.Writer, opts ConvertOptions) io.WriteCloser {
+	return transform.NewWriter(w, NewTransformer(opts))
+}
@@ -0,0 +1,112 @@
+package kana_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestReader(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := kana.Convert(tc.input, tc.options)
+
+			r := kana.NewReader(iotest.HalfReader(strings.NewReader(tc.input)), tc.options)
+			actual, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, string(actual)); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReaderLargeInput(t *testing.T) {
+	// Longer than the internal buffer, with a voiced sound mark
+	// placed across the buffer boundary.
+	input := strings.Repeat("ｱ", 1365) + "ｶﾞ" + strings.Repeat("ＡＢＣ", 1000)
+	expect := kana.Convert(input, kana.HalfwidthToWide|kana.FullwidthToNarrow)
+
+	r := kana.NewReader(strings.NewReader(input), kana.HalfwidthToWide|kana.FullwidthToNarrow)
+	actual, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Errorf("unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestReaderLongRomajiConsonants(t *testing.T) {
+	// Longer than a segment of other characters
+	input := strings.Repeat("k", 1000) + "a"
+	expect := kana.Convert(input, kana.RomajiToHiragana)
+
+	r := kana.NewReader(strings.NewReader(input), kana.RomajiToHiragana)
+	actual, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Errorf("unexpected diff (-want +got):\n%s", diff)
+	}
+
+	var buf bytes.Buffer
+	w := kana.NewWriter(&buf, kana.RomajiToHiragana)
+	if _, err := w.Write([]byte(input)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(expect, buf.String()); diff != "" {
+		t.Errorf("unexpected diff in Writer (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := kana.Convert(tc.input, tc.options)
+
+			var buf bytes.Buffer
+			w := kana.NewWriter(&buf, tc.options)
+			// Write byte by byte to split characters across writes
+			for i := 0; i < len(tc.input); i++ {
+				if _, err := w.Write([]byte{tc.input[i]}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, buf.String()); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriterFlushOnClose(t *testing.T) {
+	var buf bytes.Buffer
+	w := kana.NewWriter(&buf, kana.HalfwidthToWide)
+	if _, err := w.Write([]byte("ｱｶ")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("ア", buf.String()); diff != "" {
+		t.Errorf("unexpected diff before Close (-want +got):\n%s", diff)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("アカ", buf.String()); diff != "" {
+		t.Errorf("unexpected diff after Close (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,290 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxIterationLookbehind is the number of units looked behind
+// by the iteration marks: two kana, each of which may be followed by
+// any number of combining sound marks.
+const maxIterationLookbehind = 2
+
+// newIterationStage returns a stream that expands the iteration marks
+// and the prolonged sound marks read from strm.
+// The characters previously produced by the stage are looked behind,
+// and forgotten when the stream is restarted.
+func newIterationStage(strm *stream, opts ConvertOptions, withKana bool) *stream {
+	var behind []rune
+	stage := newStage(strm, opts, withKana, func(ch rune, strm *stream, buf *[]rune) {
+		start := len(*buf)
+		expandIterationMark(ch, strm, behind, buf, opts)
+		produced := (*buf)[start:]
+		behind = append(behind, produced...)
+		if len(produced) > 0 && !isCombiningSoundMark(produced[len(produced)-1]) {
+			// Only a new unit may push out an old one, so that
+			// the marks stacked on a kana are not scanned repeatedly
+			behind = trimLookbehind(behind)
+		}
+	})
+	stage.reset = func() {
+		behind = behind[:0]
+	}
+	return stage
+}
+
+func expandIterationMark(ch rune, strm *stream, behind []rune, buf *[]rune, opts ConvertOptions) {
+	switch ch {
+	case '\u309D', '\u30FD':
+		if opts&ExpandIterationMarks != 0 {
+			if units, ok := kanaUnitsBehind(behind, 1); ok {
+				appendKanaUnit(buf, units[0], unvoicedKana)
+				return
+			}
+		}
+	case '\u309E', '\u30FE':
+		if opts&ExpandIterationMarks != 0 {
+			if units, ok := kanaUnitsBehind(behind, 1); ok {
+				appendKanaUnit(buf, units[0], voicedKana)
+				return
+			}
+		}
+	case '\u3031', '\u3032':
+		if opts&ExpandVerticalIterationMarks != 0 {
+			if units, ok := kanaUnitsBehind(behind, 2); ok {
+				appendRepeatedKanaUnits(buf, units, ch == '\u3032')
+				return
+			}
+		}
+	case '\u3033', '\u3034':
+		if opts&ExpandVerticalIterationMarks != 0 {
+			if next, _ := strm.peekOne(); next == '\u3035' {
+				if units, ok := kanaUnitsBehind(behind, 2); ok {
+					strm.consume(1)
+					appendRepeatedKanaUnits(buf, units, ch == '\u3034')
+					return
+				}
+			}
+		}
+	case '\u30FC':
+		if opts&ExpandProlongedSoundMark != 0 {
+			if units, ok := kanaUnitsBehind(behind, 1); ok {
+				if vowel, ok := prolongedVowel(units[0].base); ok {
+					*buf = append(*buf, vowel)
+					return
+				}
+			}
+		}
+	case '\u3005', '\u303B':
+		if opts&ExpandKanjiIterationMarks != 0 && len(behind) > 0 {
+			if prev := behind[len(behind)-1]; isIterableKanji(prev) {
+				*buf = append(*buf, prev)
+				return
+			}
+		}
+	}
+	*buf = append(*buf, ch)
+}
+
+// trimLookbehind drops the characters in behind
+// before the last maxIterationLookbehind units,
+// each of which is a character followed by combining sound marks.
+func trimLookbehind(behind []rune) []rune {
+	i := len(behind)
+	for n := 0; n < maxIterationLookbehind && i > 0; n++ {
+		for i > 0 && isCombiningSoundMark(behind[i-1]) {
+			i--
+		}
+		if i > 0 {
+			i--
+		}
+	}
+	if i == 0 {
+		return behind
+	}
+	return append(behind[:0], behind[i:]...)
+}
+
+// isCombiningSoundMark reports whether ch is a combining
+// voiced or semi-voiced sound mark.
+func isCombiningSoundMark(ch rune) bool {
+	return ch == '\u3099' || ch == '\u309A'
+}
+
+// kanaUnit is a kana followed by any number of combining sound marks.
+type kanaUnit struct {
+	base rune
+	// marks is valid until the characters looked behind are updated.
+	marks []rune
+}
+
+type kanaVoicing int
+
+const (
+	keepVoicing kanaVoicing = iota
+	unvoicedKana
+	voicedKana
+)
+
+// kanaUnitsBehind returns the last n (at most 2) kana units in behind.
+func kanaUnitsBehind(behind []rune, n int) (units [2]kanaUnit, ok bool) {
+	i := len(behind)
+	for k := n - 1; k >= 0; k-- {
+		var u kanaUnit
+		end := i
+		for i > 0 && isCombiningSoundMark(behind[i-1]) {
+			i--
+		}
+		u.marks = behind[i:end]
+		if i == 0 || !isIterableKana(behind[i-1]) {
+			return units, false
+		}
+		u.base = behind[i-1]
+		i--
+		units[k] = u
+	}
+	return units, true
+}
+
+func appendKanaUnit(buf *[]rune, u kanaUnit, voicing kanaVoicing) {
+	if voicing == keepVoicing {
+		*buf = append(*buf, u.base)
+		*buf = append(*buf, u.marks...)
+		return
+	}
+	base := u.base
+	if unvoiced, ok := voicedKanaBaseTable[base]; ok {
+		base = unvoiced
+	}
+	if voicing == voicedKana {
+		if voiced, ok := voicedKanaTable[base]; ok {
+			*buf = append(*buf, voiced)
+		} else {
+			*buf = append(*buf, base, '\u3099')
+		}
+		return
+	}
+	*buf = append(*buf, base)
+}
+
+// appendRepeatedKanaUnits appends the two kana units repeated
+// by the vertical kana repeat marks, voicing the first one if voiced is true.
+func appendRepeatedKanaUnits(buf *[]rune, units [2]kanaUnit, voiced bool) {
+	if voiced {
+		appendKanaUnit(buf, units[0], voicedKana)
+	} else {
+		appendKanaUnit(buf, units[0], keepVoicing)
+	}
+	appendKanaUnit(buf, units[1], keepVoicing)
+}
+
+// prolongedVowel returns the kana that U+30FC KATAKANA-HIRAGANA
+// PROLONGED SOUND MARK (ー) following ch is replaced with.
+func prolongedVowel(ch rune) (rune, bool) {
+	var vowel byte
+	hiragana := false
+	switch {
+	case ch == '\u3093' || ch == '\u30F3':
+		// ん and ン are prolonged as is
+		return ch, true
+	case ch == '\u3063' || ch == '\u30C3':
+		// っ and ッ do not have a vowel
+		return 0, false
+	case '\u3041' <= ch && ch <= '\u3096':
+		vowel = kanaVowels[ch-'\u3041']
+		hiragana = true
+	case '\u30A1' <= ch && ch <= '\u30FA':
+		vowel = kanaVowels[ch-'\u30A1']
+	case '\u31F0' <= ch && ch <= '\u31FF':
+		vowel = smallKatakanaExtensionVowels[ch-'\u31F0']
+	default:
+		return 0, false
+	}
+	i := strings.IndexByte("aiueo", vowel)
+	if hiragana {
+		return hiraganaVowels[i], true
+	}
+	return katakanaVowels[i], true
+}
+
+// kanaVowels holds the vowels of U+30A1 KATAKANA LETTER SMALL A (ァ)
+// to U+30FA KATAKANA LETTER VO (ヺ), and likewise of U+3041 HIRAGANA LETTER
+// SMALL A (ぁ) to U+3096 HIRAGANA LETTER SMALL KE (ゖ).
+const kanaVowels = "aaiiuueeooaaiiuueeooaaiiuueeooaaiiuuueeooaiueoaaaiiiuuueeeoooaiueoaauuooaiueoaaieonuaeaieo"
+
+// smallKatakanaExtensionVowels holds the vowels of U+31F0 to U+31FF.
+const smallKatakanaExtensionVowels = "uiuouaiueouaiueo"
+
+var hiraganaVowels = [5]rune{'\u3042', '\u3044', '\u3046', '\u3048', '\u304A'}
+
+var katakanaVowels = [5]rune{'\u30A2', '\u30A4', '\u30A6', '\u30A8', '\u30AA'}
+
+func isIterableKana(ch rune) bool {
+	return '\u3041' <= ch && ch <= '\u3096' || '\u30A1' <= ch && ch <= '\u30FA' || '\u31F0' <= ch && ch <= '\u31FF'
+}
+
+func isIterableKanji(ch rune) bool {
+	return ch != '\u3005' && ch != '\u303B' && unicode.Is(unicode.Han, ch)
+}
+
+// mayBeIterated reports whether ch may be repeated by
+// the following iteration marks after the conversion,
+// or may be a part of what is repeated.
+//
+// It is conservative, like [mayChange].
+func mayBeIterated(ch rune, opts ConvertOptions) bool {
+	if opts&(ExpandIterationMarks|ExpandVerticalIterationMarks|ExpandProlongedSoundMark) != 0 {
+		if '\u3031' <= ch && ch <= '\u3035' || '\u3041' <= ch && ch <= '\u30FF' || '\u31F0' <= ch && ch <= '\u31FF' ||
+			'\uFF66' <= ch && ch <= '\uFF9F' || '\U0001B132' <= ch && ch <= '\U0001B167' {
+			return true
+		}
+		if opts&(RomajiToHiragana|RomajiToKatakana) != 0 && (mayBeRomajiInput(ch, opts) || isCombiningMark(ch)) {
+			return true
+		}
+		if _, ok := expandKanaCompatibility(ch, opts); ok {
+			return true
+		}
+	}
+	if opts&ExpandKanjiIterationMarks != 0 && (unicode.Is(unicode.Han, ch) || normalizesCompatibility(ch, opts)) {
+		return true
+	}
+	return false
+}
+
+// iterates reports whether ch may repeat prev or the characters before it.
+func iterates(prev, ch rune, opts ConvertOptions) bool {
+	if !mayBeIterated(prev, opts) {
+		return false
+	}
+	if opts&(ExpandIterationMarks|ExpandProlongedSoundMark) != 0 {
+		if '\u3099' <= ch && ch <= '\u309E' || ch == '\u30FD' || ch == '\u30FE' || ch == '\uFF9E' || ch == '\uFF9F' {
+			// Sound marks may be combined with prev before it is repeated
+			return true
+		}
+		if mayBeProlongedSoundMark(ch, opts) {
+			return true
+		}
+	}
+	if opts&ExpandVerticalIterationMarks != 0 && mayBeIterated(ch, opts) {
+		// Two characters are looked behind
+		return true
+	}
+	if opts&ExpandKanjiIterationMarks != 0 && (ch == '\u3005' || ch == '\u303B') {
+		return true
+	}
+	return false
+}
+
+// mayBeProlongedSoundMark reports whether ch may be
+// U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK after
+// the normalization, the width conversion, and the romaji conversion.
+func mayBeProlongedSoundMark(ch rune, opts ConvertOptions) bool {
+	if isProlongedSoundMark(ch) {
+		return true
+	}
+	if opts&NormalizeDashes != 0 && isDash(ch) {
+		return true
+	}
+	// '-' is converted to the prolonged sound mark in romaji
+	return opts&(RomajiToHiragana|RomajiToKatakana) != 0 && mayBeRomajiInput(ch, opts)
+}
@@ -0,0 +1,6 @@
+//go:build !exhaustive
+// +build !exhaustive
+
+package kana
+
+const exhaustiveTest = false
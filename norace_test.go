@@ -0,0 +1,6 @@
+//go:build !race
+// +build !race
+
+package kana_test
+
+const raceEnabled = false
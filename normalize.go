@@ -0,0 +1,1998 @@
+package kana
+
+// normalizeCompatibilityRune converts ch to its ordinary counterpart
+// by [NormalizeSuperscripts], [NormalizeVerticalForms],
+// [NormalizeKangxiRadicals], and [NormalizeCJKCompatibilityIdeographs].
+func normalizeCompatibilityRune(ch rune, opts ConvertOptions) rune {
+	if opts&(NormalizeSuperscripts|NormalizeVerticalForms|NormalizeKangxiRadicals|NormalizeCJKCompatibilityIdeographs) == 0 {
+		return ch
+	}
+	if opts&NormalizeSuperscripts != 0 {
+		if normalized, ok := superscriptTable[ch]; ok {
+			return normalized
+		}
+	}
+	if opts&NormalizeVerticalForms != 0 {
+		if normalized, ok := verticalFormTable[ch]; ok {
+			return normalized
+		}
+	}
+	if opts&NormalizeKangxiRadicals != 0 {
+		if normalized, ok := kangxiRadicalTable[ch]; ok {
+			return normalized
+		}
+	}
+	if opts&NormalizeCJKCompatibilityIdeographs != 0 {
+		if normalized, ok := cjkCompatibilityIdeographTable[ch]; ok {
+			return normalized
+		}
+	}
+	return ch
+}
+
+// expandCompatibility returns the sequence that ch is expanded to
+// by [ExpandKanaCompatibility], [NormalizeEnclosedAlphanumerics],
+// and [NormalizeSquaredLatinAbbreviations].
+func expandCompatibility(ch rune, opts ConvertOptions) (string, bool) {
+	if expansion, ok := expandKanaCompatibility(ch, opts); ok {
+		return expansion, true
+	}
+	if opts&NormalizeEnclosedAlphanumerics != 0 {
+		if expansion, ok := enclosedAlphanumericTable[ch]; ok {
+			return expansion, true
+		}
+	}
+	if opts&NormalizeSquaredLatinAbbreviations != 0 {
+		if expansion, ok := squaredLatinAbbreviationTable[ch]; ok {
+			return expansion, true
+		}
+	}
+	return "", false
+}
+
+// normalizeDashOrTilde converts ch by [NormalizeDashes] and [NormalizeTildes].
+// prev is the input character before ch, and the one after ch
+// is looked ahead from strm.
+func normalizeDashOrTilde(ch, prev rune, strm *stream, opts ConvertOptions) rune {
+	if opts&NormalizeDashes != 0 {
+		if isDash(ch) {
+			return '-'
+		}
+		if isProlongedSoundMark(ch) && isDigitAroundDash(prev) {
+			if next, _ := strm.peekOne(); isDigitAroundDash(next) {
+				return '-'
+			}
+		}
+	}
+	if opts&NormalizeTildes != 0 && isTilde(ch) {
+		if opts&FullwidthSymbolsToNarrow != 0 {
+			return '~'
+		}
+		return '\u301C'
+	}
+	return ch
+}
+
+func isDash(ch rune) bool {
+	return '\u2010' <= ch && ch <= '\u2015' || ch == '\u2212' || ch == '\uFE58' || ch == '\uFE63' || ch == '\uFF0D'
+}
+
+func isTilde(ch rune) bool {
+	return ch == '\u2053' || ch == '\u223C' || ch == '\u301C' || ch == '\u3030' || ch == '\uFF5E'
+}
+
+func isProlongedSoundMark(ch rune) bool {
+	return ch == '\u30FC' || ch == '\uFF70'
+}
+
+// isDigitAroundDash reports whether ch is a digit
+// that makes the prolonged sound mark next to it a dash.
+func isDigitAroundDash(ch rune) bool {
+	return '0' <= ch && ch <= '9' || '\uFF10' <= ch && ch <= '\uFF19'
+}
+
+// normalizeQuote converts ch by [NormalizeQuotes].
+func normalizeQuote(ch rune, opts ConvertOptions) rune {
+	if opts&NormalizeQuotes == 0 {
+		return ch
+	}
+	switch ch {
+	case '\u2018', '\u2019', '\u201A', '\u201B', '\u2032', '\u2035', '\uFF07':
+		return '\''
+	case '\u201C', '\u201D', '\u201E', '\u201F', '\u2033', '\u2036', '\u301D', '\u301E', '\u301F', '\uFF02':
+		return '"'
+	}
+	if opts&CornerBracketsToQuotes != 0 {
+		switch ch {
+		case '\u300C', '\u300D', '\uFF62', '\uFF63':
+			return '"'
+		case '\u300E', '\u300F':
+			return '\''
+		}
+	}
+	return ch
+}
+
+// normalizesCompatibility reports whether ch is changed by
+// normalizeCompatibilityRune or expandCompatibility.
+func normalizesCompatibility(ch rune, opts ConvertOptions) bool {
+	if normalizeCompatibilityRune(ch, opts) != ch {
+		return true
+	}
+	_, ok := expandCompatibility(ch, opts)
+	return ok
+}
+
+var enclosedAlphanumericTable = map[rune]string{
+	'\u2460':     "1",
+	'\u2461':     "2",
+	'\u2462':     "3",
+	'\u2463':     "4",
+	'\u2464':     "5",
+	'\u2465':     "6",
+	'\u2466':     "7",
+	'\u2467':     "8",
+	'\u2468':     "9",
+	'\u2469':     "10",
+	'\u246A':     "11",
+	'\u246B':     "12",
+	'\u246C':     "13",
+	'\u246D':     "14",
+	'\u246E':     "15",
+	'\u246F':     "16",
+	'\u2470':     "17",
+	'\u2471':     "18",
+	'\u2472':     "19",
+	'\u2473':     "20",
+	'\u2474':     "(1)",
+	'\u2475':     "(2)",
+	'\u2476':     "(3)",
+	'\u2477':     "(4)",
+	'\u2478':     "(5)",
+	'\u2479':     "(6)",
+	'\u247A':     "(7)",
+	'\u247B':     "(8)",
+	'\u247C':     "(9)",
+	'\u247D':     "(10)",
+	'\u247E':     "(11)",
+	'\u247F':     "(12)",
+	'\u2480':     "(13)",
+	'\u2481':     "(14)",
+	'\u2482':     "(15)",
+	'\u2483':     "(16)",
+	'\u2484':     "(17)",
+	'\u2485':     "(18)",
+	'\u2486':     "(19)",
+	'\u2487':     "(20)",
+	'\u2488':     "1.",
+	'\u2489':     "2.",
+	'\u248A':     "3.",
+	'\u248B':     "4.",
+	'\u248C':     "5.",
+	'\u248D':     "6.",
+	'\u248E':     "7.",
+	'\u248F':     "8.",
+	'\u2490':     "9.",
+	'\u2491':     "10.",
+	'\u2492':     "11.",
+	'\u2493':     "12.",
+	'\u2494':     "13.",
+	'\u2495':     "14.",
+	'\u2496':     "15.",
+	'\u2497':     "16.",
+	'\u2498':     "17.",
+	'\u2499':     "18.",
+	'\u249A':     "19.",
+	'\u249B':     "20.",
+	'\u249C':     "(a)",
+	'\u249D':     "(b)",
+	'\u249E':     "(c)",
+	'\u249F':     "(d)",
+	'\u24A0':     "(e)",
+	'\u24A1':     "(f)",
+	'\u24A2':     "(g)",
+	'\u24A3':     "(h)",
+	'\u24A4':     "(i)",
+	'\u24A5':     "(j)",
+	'\u24A6':     "(k)",
+	'\u24A7':     "(l)",
+	'\u24A8':     "(m)",
+	'\u24A9':     "(n)",
+	'\u24AA':     "(o)",
+	'\u24AB':     "(p)",
+	'\u24AC':     "(q)",
+	'\u24AD':     "(r)",
+	'\u24AE':     "(s)",
+	'\u24AF':     "(t)",
+	'\u24B0':     "(u)",
+	'\u24B1':     "(v)",
+	'\u24B2':     "(w)",
+	'\u24B3':     "(x)",
+	'\u24B4':     "(y)",
+	'\u24B5':     "(z)",
+	'\u24B6':     "A",
+	'\u24B7':     "B",
+	'\u24B8':     "C",
+	'\u24B9':     "D",
+	'\u24BA':     "E",
+	'\u24BB':     "F",
+	'\u24BC':     "G",
+	'\u24BD':     "H",
+	'\u24BE':     "I",
+	'\u24BF':     "J",
+	'\u24C0':     "K",
+	'\u24C1':     "L",
+	'\u24C2':     "M",
+	'\u24C3':     "N",
+	'\u24C4':     "O",
+	'\u24C5':     "P",
+	'\u24C6':     "Q",
+	'\u24C7':     "R",
+	'\u24C8':     "S",
+	'\u24C9':     "T",
+	'\u24CA':     "U",
+	'\u24CB':     "V",
+	'\u24CC':     "W",
+	'\u24CD':     "X",
+	'\u24CE':     "Y",
+	'\u24CF':     "Z",
+	'\u24D0':     "a",
+	'\u24D1':     "b",
+	'\u24D2':     "c",
+	'\u24D3':     "d",
+	'\u24D4':     "e",
+	'\u24D5':     "f",
+	'\u24D6':     "g",
+	'\u24D7':     "h",
+	'\u24D8':     "i",
+	'\u24D9':     "j",
+	'\u24DA':     "k",
+	'\u24DB':     "l",
+	'\u24DC':     "m",
+	'\u24DD':     "n",
+	'\u24DE':     "o",
+	'\u24DF':     "p",
+	'\u24E0':     "q",
+	'\u24E1':     "r",
+	'\u24E2':     "s",
+	'\u24E3':     "t",
+	'\u24E4':     "u",
+	'\u24E5':     "v",
+	'\u24E6':     "w",
+	'\u24E7':     "x",
+	'\u24E8':     "y",
+	'\u24E9':     "z",
+	'\u24EA':     "0",
+	'\u3251':     "21",
+	'\u3252':     "22",
+	'\u3253':     "23",
+	'\u3254':     "24",
+	'\u3255':     "25",
+	'\u3256':     "26",
+	'\u3257':     "27",
+	'\u3258':     "28",
+	'\u3259':     "29",
+	'\u325A':     "30",
+	'\u325B':     "31",
+	'\u325C':     "32",
+	'\u325D':     "33",
+	'\u325E':     "34",
+	'\u325F':     "35",
+	'\u32B1':     "36",
+	'\u32B2':     "37",
+	'\u32B3':     "38",
+	'\u32B4':     "39",
+	'\u32B5':     "40",
+	'\u32B6':     "41",
+	'\u32B7':     "42",
+	'\u32B8':     "43",
+	'\u32B9':     "44",
+	'\u32BA':     "45",
+	'\u32BB':     "46",
+	'\u32BC':     "47",
+	'\u32BD':     "48",
+	'\u32BE':     "49",
+	'\u32BF':     "50",
+	'\U0001F100': "0.",
+	'\U0001F101': "0,",
+	'\U0001F102': "1,",
+	'\U0001F103': "2,",
+	'\U0001F104': "3,",
+	'\U0001F105': "4,",
+	'\U0001F106': "5,",
+	'\U0001F107': "6,",
+	'\U0001F108': "7,",
+	'\U0001F109': "8,",
+	'\U0001F10A': "9,",
+	'\U0001F110': "(A)",
+	'\U0001F111': "(B)",
+	'\U0001F112': "(C)",
+	'\U0001F113': "(D)",
+	'\U0001F114': "(E)",
+	'\U0001F115': "(F)",
+	'\U0001F116': "(G)",
+	'\U0001F117': "(H)",
+	'\U0001F118': "(I)",
+	'\U0001F119': "(J)",
+	'\U0001F11A': "(K)",
+	'\U0001F11B': "(L)",
+	'\U0001F11C': "(M)",
+	'\U0001F11D': "(N)",
+	'\U0001F11E': "(O)",
+	'\U0001F11F': "(P)",
+	'\U0001F120': "(Q)",
+	'\U0001F121': "(R)",
+	'\U0001F122': "(S)",
+	'\U0001F123': "(T)",
+	'\U0001F124': "(U)",
+	'\U0001F125': "(V)",
+	'\U0001F126': "(W)",
+	'\U0001F127': "(X)",
+	'\U0001F128': "(Y)",
+	'\U0001F129': "(Z)",
+	'\U0001F12A': "\u3014S\u3015",
+	'\U0001F12B': "C",
+	'\U0001F12C': "R",
+	'\U0001F12D': "CD",
+	'\U0001F12E': "WZ",
+	'\U0001F130': "A",
+	'\U0001F131': "B",
+	'\U0001F132': "C",
+	'\U0001F133': "D",
+	'\U0001F134': "E",
+	'\U0001F135': "F",
+	'\U0001F136': "G",
+	'\U0001F137': "H",
+	'\U0001F138': "I",
+	'\U0001F139': "J",
+	'\U0001F13A': "K",
+	'\U0001F13B': "L",
+	'\U0001F13C': "M",
+	'\U0001F13D': "N",
+	'\U0001F13E': "O",
+	'\U0001F13F': "P",
+	'\U0001F140': "Q",
+	'\U0001F141': "R",
+	'\U0001F142': "S",
+	'\U0001F143': "T",
+	'\U0001F144': "U",
+	'\U0001F145': "V",
+	'\U0001F146': "W",
+	'\U0001F147': "X",
+	'\U0001F148': "Y",
+	'\U0001F149': "Z",
+}
+
+var squaredLatinAbbreviationTable = map[rune]string{
+	'\u3250':     "PTE",
+	'\u32CC':     "Hg",
+	'\u32CD':     "erg",
+	'\u32CE':     "eV",
+	'\u32CF':     "LTD",
+	'\u3371':     "hPa",
+	'\u3372':     "da",
+	'\u3373':     "AU",
+	'\u3374':     "bar",
+	'\u3375':     "oV",
+	'\u3376':     "pc",
+	'\u3377':     "dm",
+	'\u3378':     "dm2",
+	'\u3379':     "dm3",
+	'\u337A':     "IU",
+	'\u3380':     "pA",
+	'\u3381':     "nA",
+	'\u3382':     "\u03BCA",
+	'\u3383':     "mA",
+	'\u3384':     "kA",
+	'\u3385':     "KB",
+	'\u3386':     "MB",
+	'\u3387':     "GB",
+	'\u3388':     "cal",
+	'\u3389':     "kcal",
+	'\u338A':     "pF",
+	'\u338B':     "nF",
+	'\u338C':     "\u03BCF",
+	'\u338D':     "\u03BCg",
+	'\u338E':     "mg",
+	'\u338F':     "kg",
+	'\u3390':     "Hz",
+	'\u3391':     "kHz",
+	'\u3392':     "MHz",
+	'\u3393':     "GHz",
+	'\u3394':     "THz",
+	'\u3395':     "\u03BCl",
+	'\u3396':     "ml",
+	'\u3397':     "dl",
+	'\u3398':     "kl",
+	'\u3399':     "fm",
+	'\u339A':     "nm",
+	'\u339B':     "\u03BCm",
+	'\u339C':     "mm",
+	'\u339D':     "cm",
+	'\u339E':     "km",
+	'\u339F':     "mm2",
+	'\u33A0':     "cm2",
+	'\u33A1':     "m2",
+	'\u33A2':     "km2",
+	'\u33A3':     "mm3",
+	'\u33A4':     "cm3",
+	'\u33A5':     "m3",
+	'\u33A6':     "km3",
+	'\u33A7':     "m\u2215s",
+	'\u33A8':     "m\u2215s2",
+	'\u33A9':     "Pa",
+	'\u33AA':     "kPa",
+	'\u33AB':     "MPa",
+	'\u33AC':     "GPa",
+	'\u33AD':     "rad",
+	'\u33AE':     "rad\u2215s",
+	'\u33AF':     "rad\u2215s2",
+	'\u33B0':     "ps",
+	'\u33B1':     "ns",
+	'\u33B2':     "\u03BCs",
+	'\u33B3':     "ms",
+	'\u33B4':     "pV",
+	'\u33B5':     "nV",
+	'\u33B6':     "\u03BCV",
+	'\u33B7':     "mV",
+	'\u33B8':     "kV",
+	'\u33B9':     "MV",
+	'\u33BA':     "pW",
+	'\u33BB':     "nW",
+	'\u33BC':     "\u03BCW",
+	'\u33BD':     "mW",
+	'\u33BE':     "kW",
+	'\u33BF':     "MW",
+	'\u33C0':     "k\u03A9",
+	'\u33C1':     "M\u03A9",
+	'\u33C2':     "a.m.",
+	'\u33C3':     "Bq",
+	'\u33C4':     "cc",
+	'\u33C5':     "cd",
+	'\u33C6':     "C\u2215kg",
+	'\u33C7':     "Co.",
+	'\u33C8':     "dB",
+	'\u33C9':     "Gy",
+	'\u33CA':     "ha",
+	'\u33CB':     "HP",
+	'\u33CC':     "in",
+	'\u33CD':     "KK",
+	'\u33CE':     "KM",
+	'\u33CF':     "kt",
+	'\u33D0':     "lm",
+	'\u33D1':     "ln",
+	'\u33D2':     "log",
+	'\u33D3':     "lx",
+	'\u33D4':     "mb",
+	'\u33D5':     "mil",
+	'\u33D6':     "mol",
+	'\u33D7':     "PH",
+	'\u33D8':     "p.m.",
+	'\u33D9':     "PPM",
+	'\u33DA':     "PR",
+	'\u33DB':     "sr",
+	'\u33DC':     "Sv",
+	'\u33DD':     "Wb",
+	'\u33DE':     "V\u2215m",
+	'\u33DF':     "A\u2215m",
+	'\u33FF':     "gal",
+	'\U0001F14A': "HV",
+	'\U0001F14B': "MV",
+	'\U0001F14C': "SD",
+	'\U0001F14D': "SS",
+	'\U0001F14E': "PPV",
+	'\U0001F14F': "WC",
+	'\U0001F16A': "MC",
+	'\U0001F16B': "MD",
+	'\U0001F16C': "MR",
+	'\U0001F190': "DJ",
+}
+
+var superscriptTable = map[rune]rune{
+	'\u00AA':     '\u0061',
+	'\u00B2':     '\u0032',
+	'\u00B3':     '\u0033',
+	'\u00B9':     '\u0031',
+	'\u00BA':     '\u006F',
+	'\u02B0':     '\u0068',
+	'\u02B1':     '\u0266',
+	'\u02B2':     '\u006A',
+	'\u02B3':     '\u0072',
+	'\u02B4':     '\u0279',
+	'\u02B5':     '\u027B',
+	'\u02B6':     '\u0281',
+	'\u02B7':     '\u0077',
+	'\u02B8':     '\u0079',
+	'\u02E0':     '\u0263',
+	'\u02E1':     '\u006C',
+	'\u02E2':     '\u0073',
+	'\u02E3':     '\u0078',
+	'\u02E4':     '\u0295',
+	'\u10FC':     '\u10DC',
+	'\u1D2C':     '\u0041',
+	'\u1D2D':     '\u00C6',
+	'\u1D2E':     '\u0042',
+	'\u1D30':     '\u0044',
+	'\u1D31':     '\u0045',
+	'\u1D32':     '\u018E',
+	'\u1D33':     '\u0047',
+	'\u1D34':     '\u0048',
+	'\u1D35':     '\u0049',
+	'\u1D36':     '\u004A',
+	'\u1D37':     '\u004B',
+	'\u1D38':     '\u004C',
+	'\u1D39':     '\u004D',
+	'\u1D3A':     '\u004E',
+	'\u1D3C':     '\u004F',
+	'\u1D3D':     '\u0222',
+	'\u1D3E':     '\u0050',
+	'\u1D3F':     '\u0052',
+	'\u1D40':     '\u0054',
+	'\u1D41':     '\u0055',
+	'\u1D42':     '\u0057',
+	'\u1D43':     '\u0061',
+	'\u1D44':     '\u0250',
+	'\u1D45':     '\u0251',
+	'\u1D46':     '\u1D02',
+	'\u1D47':     '\u0062',
+	'\u1D48':     '\u0064',
+	'\u1D49':     '\u0065',
+	'\u1D4A':     '\u0259',
+	'\u1D4B':     '\u025B',
+	'\u1D4C':     '\u025C',
+	'\u1D4D':     '\u0067',
+	'\u1D4F':     '\u006B',
+	'\u1D50':     '\u006D',
+	'\u1D51':     '\u014B',
+	'\u1D52':     '\u006F',
+	'\u1D53':     '\u0254',
+	'\u1D54':     '\u1D16',
+	'\u1D55':     '\u1D17',
+	'\u1D56':     '\u0070',
+	'\u1D57':     '\u0074',
+	'\u1D58':     '\u0075',
+	'\u1D59':     '\u1D1D',
+	'\u1D5A':     '\u026F',
+	'\u1D5B':     '\u0076',
+	'\u1D5C':     '\u1D25',
+	'\u1D5D':     '\u03B2',
+	'\u1D5E':     '\u03B3',
+	'\u1D5F':     '\u03B4',
+	'\u1D60':     '\u03C6',
+	'\u1D61':     '\u03C7',
+	'\u1D62':     '\u0069',
+	'\u1D63':     '\u0072',
+	'\u1D64':     '\u0075',
+	'\u1D65':     '\u0076',
+	'\u1D66':     '\u03B2',
+	'\u1D67':     '\u03B3',
+	'\u1D68':     '\u03C1',
+	'\u1D69':     '\u03C6',
+	'\u1D6A':     '\u03C7',
+	'\u1D78':     '\u043D',
+	'\u1D9B':     '\u0252',
+	'\u1D9C':     '\u0063',
+	'\u1D9D':     '\u0255',
+	'\u1D9E':     '\u00F0',
+	'\u1D9F':     '\u025C',
+	'\u1DA0':     '\u0066',
+	'\u1DA1':     '\u025F',
+	'\u1DA2':     '\u0261',
+	'\u1DA3':     '\u0265',
+	'\u1DA4':     '\u0268',
+	'\u1DA5':     '\u0269',
+	'\u1DA6':     '\u026A',
+	'\u1DA7':     '\u1D7B',
+	'\u1DA8':     '\u029D',
+	'\u1DA9':     '\u026D',
+	'\u1DAA':     '\u1D85',
+	'\u1DAB':     '\u029F',
+	'\u1DAC':     '\u0271',
+	'\u1DAD':     '\u0270',
+	'\u1DAE':     '\u0272',
+	'\u1DAF':     '\u0273',
+	'\u1DB0':     '\u0274',
+	'\u1DB1':     '\u0275',
+	'\u1DB2':     '\u0278',
+	'\u1DB3':     '\u0282',
+	'\u1DB4':     '\u0283',
+	'\u1DB5':     '\u01AB',
+	'\u1DB6':     '\u0289',
+	'\u1DB7':     '\u028A',
+	'\u1DB8':     '\u1D1C',
+	'\u1DB9':     '\u028B',
+	'\u1DBA':     '\u028C',
+	'\u1DBB':     '\u007A',
+	'\u1DBC':     '\u0290',
+	'\u1DBD':     '\u0291',
+	'\u1DBE':     '\u0292',
+	'\u1DBF':     '\u03B8',
+	'\u2070':     '\u0030',
+	'\u2071':     '\u0069',
+	'\u2074':     '\u0034',
+	'\u2075':     '\u0035',
+	'\u2076':     '\u0036',
+	'\u2077':     '\u0037',
+	'\u2078':     '\u0038',
+	'\u2079':     '\u0039',
+	'\u207A':     '\u002B',
+	'\u207B':     '\u2212',
+	'\u207C':     '\u003D',
+	'\u207D':     '\u0028',
+	'\u207E':     '\u0029',
+	'\u207F':     '\u006E',
+	'\u2080':     '\u0030',
+	'\u2081':     '\u0031',
+	'\u2082':     '\u0032',
+	'\u2083':     '\u0033',
+	'\u2084':     '\u0034',
+	'\u2085':     '\u0035',
+	'\u2086':     '\u0036',
+	'\u2087':     '\u0037',
+	'\u2088':     '\u0038',
+	'\u2089':     '\u0039',
+	'\u208A':     '\u002B',
+	'\u208B':     '\u2212',
+	'\u208C':     '\u003D',
+	'\u208D':     '\u0028',
+	'\u208E':     '\u0029',
+	'\u2090':     '\u0061',
+	'\u2091':     '\u0065',
+	'\u2092':     '\u006F',
+	'\u2093':     '\u0078',
+	'\u2094':     '\u0259',
+	'\u2095':     '\u0068',
+	'\u2096':     '\u006B',
+	'\u2097':     '\u006C',
+	'\u2098':     '\u006D',
+	'\u2099':     '\u006E',
+	'\u209A':     '\u0070',
+	'\u209B':     '\u0073',
+	'\u209C':     '\u0074',
+	'\u2C7C':     '\u006A',
+	'\u2C7D':     '\u0056',
+	'\u2D6F':     '\u2D61',
+	'\u3192':     '\u4E00',
+	'\u3193':     '\u4E8C',
+	'\u3194':     '\u4E09',
+	'\u3195':     '\u56DB',
+	'\u3196':     '\u4E0A',
+	'\u3197':     '\u4E2D',
+	'\u3198':     '\u4E0B',
+	'\u3199':     '\u7532',
+	'\u319A':     '\u4E59',
+	'\u319B':     '\u4E19',
+	'\u319C':     '\u4E01',
+	'\u319D':     '\u5929',
+	'\u319E':     '\u5730',
+	'\u319F':     '\u4EBA',
+	'\uA69C':     '\u044A',
+	'\uA69D':     '\u044C',
+	'\uA770':     '\uA76F',
+	'\uA7F2':     '\u0043',
+	'\uA7F3':     '\u0046',
+	'\uA7F4':     '\u0051',
+	'\uA7F8':     '\u0126',
+	'\uA7F9':     '\u0153',
+	'\uAB5C':     '\uA727',
+	'\uAB5D':     '\uAB37',
+	'\uAB5E':     '\u026B',
+	'\uAB5F':     '\uAB52',
+	'\uAB69':     '\u028D',
+	'\U00010781': '\u02D0',
+	'\U00010782': '\u02D1',
+	'\U00010783': '\u00E6',
+	'\U00010784': '\u0299',
+	'\U00010785': '\u0253',
+	'\U00010787': '\u02A3',
+	'\U00010788': '\uAB66',
+	'\U00010789': '\u02A5',
+	'\U0001078A': '\u02A4',
+	'\U0001078B': '\u0256',
+	'\U0001078C': '\u0257',
+	'\U0001078D': '\u1D91',
+	'\U0001078E': '\u0258',
+	'\U0001078F': '\u025E',
+	'\U00010790': '\u02A9',
+	'\U00010791': '\u0264',
+	'\U00010792': '\u0262',
+	'\U00010793': '\u0260',
+	'\U00010794': '\u029B',
+	'\U00010795': '\u0127',
+	'\U00010796': '\u029C',
+	'\U00010797': '\u0267',
+	'\U00010798': '\u0284',
+	'\U00010799': '\u02AA',
+	'\U0001079A': '\u02AB',
+	'\U0001079B': '\u026C',
+	'\U0001079C': '\U0001DF04',
+	'\U0001079D': '\uA78E',
+	'\U0001079E': '\u026E',
+	'\U0001079F': '\U0001DF05',
+	'\U000107A0': '\u028E',
+	'\U000107A1': '\U0001DF06',
+	'\U000107A2': '\u00F8',
+	'\U000107A3': '\u0276',
+	'\U000107A4': '\u0277',
+	'\U000107A5': '\u0071',
+	'\U000107A6': '\u027A',
+	'\U000107A7': '\U0001DF08',
+	'\U000107A8': '\u027D',
+	'\U000107A9': '\u027E',
+	'\U000107AA': '\u0280',
+	'\U000107AB': '\u02A8',
+	'\U000107AC': '\u02A6',
+	'\U000107AD': '\uAB67',
+	'\U000107AE': '\u02A7',
+	'\U000107AF': '\u0288',
+	'\U000107B0': '\u2C71',
+	'\U000107B2': '\u028F',
+	'\U000107B3': '\u02A1',
+	'\U000107B4': '\u02A2',
+	'\U000107B5': '\u0298',
+	'\U000107B6': '\u01C0',
+	'\U000107B7': '\u01C1',
+	'\U000107B8': '\u01C2',
+	'\U000107B9': '\U0001DF0A',
+	'\U000107BA': '\U0001DF1E',
+}
+
+var verticalFormTable = map[rune]rune{
+	'\uFE10': '\uFF0C',
+	'\uFE11': '\u3001',
+	'\uFE12': '\u3002',
+	'\uFE13': '\uFF1A',
+	'\uFE14': '\uFF1B',
+	'\uFE15': '\uFF01',
+	'\uFE16': '\uFF1F',
+	'\uFE17': '\u3016',
+	'\uFE18': '\u3017',
+	'\uFE19': '\u2026',
+	'\uFE30': '\u2025',
+	'\uFE31': '\u2014',
+	'\uFE32': '\u2013',
+	'\uFE33': '\uFF3F',
+	'\uFE34': '\uFF3F',
+	'\uFE35': '\uFF08',
+	'\uFE36': '\uFF09',
+	'\uFE37': '\uFF5B',
+	'\uFE38': '\uFF5D',
+	'\uFE39': '\u3014',
+	'\uFE3A': '\u3015',
+	'\uFE3B': '\u3010',
+	'\uFE3C': '\u3011',
+	'\uFE3D': '\u300A',
+	'\uFE3E': '\u300B',
+	'\uFE3F': '\u3008',
+	'\uFE40': '\u3009',
+	'\uFE41': '\u300C',
+	'\uFE42': '\u300D',
+	'\uFE43': '\u300E',
+	'\uFE44': '\u300F',
+	'\uFE47': '\uFF3B',
+	'\uFE48': '\uFF3D',
+}
+
+var kangxiRadicalTable = map[rune]rune{
+	'\u2E9F': '\u6BCD',
+	'\u2EF3': '\u9F9F',
+	'\u2F00': '\u4E00',
+	'\u2F01': '\u4E28',
+	'\u2F02': '\u4E36',
+	'\u2F03': '\u4E3F',
+	'\u2F04': '\u4E59',
+	'\u2F05': '\u4E85',
+	'\u2F06': '\u4E8C',
+	'\u2F07': '\u4EA0',
+	'\u2F08': '\u4EBA',
+	'\u2F09': '\u513F',
+	'\u2F0A': '\u5165',
+	'\u2F0B': '\u516B',
+	'\u2F0C': '\u5182',
+	'\u2F0D': '\u5196',
+	'\u2F0E': '\u51AB',
+	'\u2F0F': '\u51E0',
+	'\u2F10': '\u51F5',
+	'\u2F11': '\u5200',
+	'\u2F12': '\u529B',
+	'\u2F13': '\u52F9',
+	'\u2F14': '\u5315',
+	'\u2F15': '\u531A',
+	'\u2F16': '\u5338',
+	'\u2F17': '\u5341',
+	'\u2F18': '\u535C',
+	'\u2F19': '\u5369',
+	'\u2F1A': '\u5382',
+	'\u2F1B': '\u53B6',
+	'\u2F1C': '\u53C8',
+	'\u2F1D': '\u53E3',
+	'\u2F1E': '\u56D7',
+	'\u2F1F': '\u571F',
+	'\u2F20': '\u58EB',
+	'\u2F21': '\u5902',
+	'\u2F22': '\u590A',
+	'\u2F23': '\u5915',
+	'\u2F24': '\u5927',
+	'\u2F25': '\u5973',
+	'\u2F26': '\u5B50',
+	'\u2F27': '\u5B80',
+	'\u2F28': '\u5BF8',
+	'\u2F29': '\u5C0F',
+	'\u2F2A': '\u5C22',
+	'\u2F2B': '\u5C38',
+	'\u2F2C': '\u5C6E',
+	'\u2F2D': '\u5C71',
+	'\u2F2E': '\u5DDB',
+	'\u2F2F': '\u5DE5',
+	'\u2F30': '\u5DF1',
+	'\u2F31': '\u5DFE',
+	'\u2F32': '\u5E72',
+	'\u2F33': '\u5E7A',
+	'\u2F34': '\u5E7F',
+	'\u2F35': '\u5EF4',
+	'\u2F36': '\u5EFE',
+	'\u2F37': '\u5F0B',
+	'\u2F38': '\u5F13',
+	'\u2F39': '\u5F50',
+	'\u2F3A': '\u5F61',
+	'\u2F3B': '\u5F73',
+	'\u2F3C': '\u5FC3',
+	'\u2F3D': '\u6208',
+	'\u2F3E': '\u6236',
+	'\u2F3F': '\u624B',
+	'\u2F40': '\u652F',
+	'\u2F41': '\u6534',
+	'\u2F42': '\u6587',
+	'\u2F43': '\u6597',
+	'\u2F44': '\u65A4',
+	'\u2F45': '\u65B9',
+	'\u2F46': '\u65E0',
+	'\u2F47': '\u65E5',
+	'\u2F48': '\u66F0',
+	'\u2F49': '\u6708',
+	'\u2F4A': '\u6728',
+	'\u2F4B': '\u6B20',
+	'\u2F4C': '\u6B62',
+	'\u2F4D': '\u6B79',
+	'\u2F4E': '\u6BB3',
+	'\u2F4F': '\u6BCB',
+	'\u2F50': '\u6BD4',
+	'\u2F51': '\u6BDB',
+	'\u2F52': '\u6C0F',
+	'\u2F53': '\u6C14',
+	'\u2F54': '\u6C34',
+	'\u2F55': '\u706B',
+	'\u2F56': '\u722A',
+	'\u2F57': '\u7236',
+	'\u2F58': '\u723B',
+	'\u2F59': '\u723F',
+	'\u2F5A': '\u7247',
+	'\u2F5B': '\u7259',
+	'\u2F5C': '\u725B',
+	'\u2F5D': '\u72AC',
+	'\u2F5E': '\u7384',
+	'\u2F5F': '\u7389',
+	'\u2F60': '\u74DC',
+	'\u2F61': '\u74E6',
+	'\u2F62': '\u7518',
+	'\u2F63': '\u751F',
+	'\u2F64': '\u7528',
+	'\u2F65': '\u7530',
+	'\u2F66': '\u758B',
+	'\u2F67': '\u7592',
+	'\u2F68': '\u7676',
+	'\u2F69': '\u767D',
+	'\u2F6A': '\u76AE',
+	'\u2F6B': '\u76BF',
+	'\u2F6C': '\u76EE',
+	'\u2F6D': '\u77DB',
+	'\u2F6E': '\u77E2',
+	'\u2F6F': '\u77F3',
+	'\u2F70': '\u793A',
+	'\u2F71': '\u79B8',
+	'\u2F72': '\u79BE',
+	'\u2F73': '\u7A74',
+	'\u2F74': '\u7ACB',
+	'\u2F75': '\u7AF9',
+	'\u2F76': '\u7C73',
+	'\u2F77': '\u7CF8',
+	'\u2F78': '\u7F36',
+	'\u2F79': '\u7F51',
+	'\u2F7A': '\u7F8A',
+	'\u2F7B': '\u7FBD',
+	'\u2F7C': '\u8001',
+	'\u2F7D': '\u800C',
+	'\u2F7E': '\u8012',
+	'\u2F7F': '\u8033',
+	'\u2F80': '\u807F',
+	'\u2F81': '\u8089',
+	'\u2F82': '\u81E3',
+	'\u2F83': '\u81EA',
+	'\u2F84': '\u81F3',
+	'\u2F85': '\u81FC',
+	'\u2F86': '\u820C',
+	'\u2F87': '\u821B',
+	'\u2F88': '\u821F',
+	'\u2F89': '\u826E',
+	'\u2F8A': '\u8272',
+	'\u2F8B': '\u8278',
+	'\u2F8C': '\u864D',
+	'\u2F8D': '\u866B',
+	'\u2F8E': '\u8840',
+	'\u2F8F': '\u884C',
+	'\u2F90': '\u8863',
+	'\u2F91': '\u897E',
+	'\u2F92': '\u898B',
+	'\u2F93': '\u89D2',
+	'\u2F94': '\u8A00',
+	'\u2F95': '\u8C37',
+	'\u2F96': '\u8C46',
+	'\u2F97': '\u8C55',
+	'\u2F98': '\u8C78',
+	'\u2F99': '\u8C9D',
+	'\u2F9A': '\u8D64',
+	'\u2F9B': '\u8D70',
+	'\u2F9C': '\u8DB3',
+	'\u2F9D': '\u8EAB',
+	'\u2F9E': '\u8ECA',
+	'\u2F9F': '\u8F9B',
+	'\u2FA0': '\u8FB0',
+	'\u2FA1': '\u8FB5',
+	'\u2FA2': '\u9091',
+	'\u2FA3': '\u9149',
+	'\u2FA4': '\u91C6',
+	'\u2FA5': '\u91CC',
+	'\u2FA6': '\u91D1',
+	'\u2FA7': '\u9577',
+	'\u2FA8': '\u9580',
+	'\u2FA9': '\u961C',
+	'\u2FAA': '\u96B6',
+	'\u2FAB': '\u96B9',
+	'\u2FAC': '\u96E8',
+	'\u2FAD': '\u9751',
+	'\u2FAE': '\u975E',
+	'\u2FAF': '\u9762',
+	'\u2FB0': '\u9769',
+	'\u2FB1': '\u97CB',
+	'\u2FB2': '\u97ED',
+	'\u2FB3': '\u97F3',
+	'\u2FB4': '\u9801',
+	'\u2FB5': '\u98A8',
+	'\u2FB6': '\u98DB',
+	'\u2FB7': '\u98DF',
+	'\u2FB8': '\u9996',
+	'\u2FB9': '\u9999',
+	'\u2FBA': '\u99AC',
+	'\u2FBB': '\u9AA8',
+	'\u2FBC': '\u9AD8',
+	'\u2FBD': '\u9ADF',
+	'\u2FBE': '\u9B25',
+	'\u2FBF': '\u9B2F',
+	'\u2FC0': '\u9B32',
+	'\u2FC1': '\u9B3C',
+	'\u2FC2': '\u9B5A',
+	'\u2FC3': '\u9CE5',
+	'\u2FC4': '\u9E75',
+	'\u2FC5': '\u9E7F',
+	'\u2FC6': '\u9EA5',
+	'\u2FC7': '\u9EBB',
+	'\u2FC8': '\u9EC3',
+	'\u2FC9': '\u9ECD',
+	'\u2FCA': '\u9ED1',
+	'\u2FCB': '\u9EF9',
+	'\u2FCC': '\u9EFD',
+	'\u2FCD': '\u9F0E',
+	'\u2FCE': '\u9F13',
+	'\u2FCF': '\u9F20',
+	'\u2FD0': '\u9F3B',
+	'\u2FD1': '\u9F4A',
+	'\u2FD2': '\u9F52',
+	'\u2FD3': '\u9F8D',
+	'\u2FD4': '\u9F9C',
+	'\u2FD5': '\u9FA0',
+}
+
+var cjkCompatibilityIdeographTable = map[rune]rune{
+	'\uF900':     '\u8C48',
+	'\uF901':     '\u66F4',
+	'\uF902':     '\u8ECA',
+	'\uF903':     '\u8CC8',
+	'\uF904':     '\u6ED1',
+	'\uF905':     '\u4E32',
+	'\uF906':     '\u53E5',
+	'\uF907':     '\u9F9C',
+	'\uF908':     '\u9F9C',
+	'\uF909':     '\u5951',
+	'\uF90A':     '\u91D1',
+	'\uF90B':     '\u5587',
+	'\uF90C':     '\u5948',
+	'\uF90D':     '\u61F6',
+	'\uF90E':     '\u7669',
+	'\uF90F':     '\u7F85',
+	'\uF910':     '\u863F',
+	'\uF911':     '\u87BA',
+	'\uF912':     '\u88F8',
+	'\uF913':     '\u908F',
+	'\uF914':     '\u6A02',
+	'\uF915':     '\u6D1B',
+	'\uF916':     '\u70D9',
+	'\uF917':     '\u73DE',
+	'\uF918':     '\u843D',
+	'\uF919':     '\u916A',
+	'\uF91A':     '\u99F1',
+	'\uF91B':     '\u4E82',
+	'\uF91C':     '\u5375',
+	'\uF91D':     '\u6B04',
+	'\uF91E':     '\u721B',
+	'\uF91F':     '\u862D',
+	'\uF920':     '\u9E1E',
+	'\uF921':     '\u5D50',
+	'\uF922':     '\u6FEB',
+	'\uF923':     '\u85CD',
+	'\uF924':     '\u8964',
+	'\uF925':     '\u62C9',
+	'\uF926':     '\u81D8',
+	'\uF927':     '\u881F',
+	'\uF928':     '\u5ECA',
+	'\uF929':     '\u6717',
+	'\uF92A':     '\u6D6A',
+	'\uF92B':     '\u72FC',
+	'\uF92C':     '\u90CE',
+	'\uF92D':     '\u4F86',
+	'\uF92E':     '\u51B7',
+	'\uF92F':     '\u52DE',
+	'\uF930':     '\u64C4',
+	'\uF931':     '\u6AD3',
+	'\uF932':     '\u7210',
+	'\uF933':     '\u76E7',
+	'\uF934':     '\u8001',
+	'\uF935':     '\u8606',
+	'\uF936':     '\u865C',
+	'\uF937':     '\u8DEF',
+	'\uF938':     '\u9732',
+	'\uF939':     '\u9B6F',
+	'\uF93A':     '\u9DFA',
+	'\uF93B':     '\u788C',
+	'\uF93C':     '\u797F',
+	'\uF93D':     '\u7DA0',
+	'\uF93E':     '\u83C9',
+	'\uF93F':     '\u9304',
+	'\uF940':     '\u9E7F',
+	'\uF941':     '\u8AD6',
+	'\uF942':     '\u58DF',
+	'\uF943':     '\u5F04',
+	'\uF944':     '\u7C60',
+	'\uF945':     '\u807E',
+	'\uF946':     '\u7262',
+	'\uF947':     '\u78CA',
+	'\uF948':     '\u8CC2',
+	'\uF949':     '\u96F7',
+	'\uF94A':     '\u58D8',
+	'\uF94B':     '\u5C62',
+	'\uF94C':     '\u6A13',
+	'\uF94D':     '\u6DDA',
+	'\uF94E':     '\u6F0F',
+	'\uF94F':     '\u7D2F',
+	'\uF950':     '\u7E37',
+	'\uF951':     '\u964B',
+	'\uF952':     '\u52D2',
+	'\uF953':     '\u808B',
+	'\uF954':     '\u51DC',
+	'\uF955':     '\u51CC',
+	'\uF956':     '\u7A1C',
+	'\uF957':     '\u7DBE',
+	'\uF958':     '\u83F1',
+	'\uF959':     '\u9675',
+	'\uF95A':     '\u8B80',
+	'\uF95B':     '\u62CF',
+	'\uF95C':     '\u6A02',
+	'\uF95D':     '\u8AFE',
+	'\uF95E':     '\u4E39',
+	'\uF95F':     '\u5BE7',
+	'\uF960':     '\u6012',
+	'\uF961':     '\u7387',
+	'\uF962':     '\u7570',
+	'\uF963':     '\u5317',
+	'\uF964':     '\u78FB',
+	'\uF965':     '\u4FBF',
+	'\uF966':     '\u5FA9',
+	'\uF967':     '\u4E0D',
+	'\uF968':     '\u6CCC',
+	'\uF969':     '\u6578',
+	'\uF96A':     '\u7D22',
+	'\uF96B':     '\u53C3',
+	'\uF96C':     '\u585E',
+	'\uF96D':     '\u7701',
+	'\uF96E':     '\u8449',
+	'\uF96F':     '\u8AAA',
+	'\uF970':     '\u6BBA',
+	'\uF971':     '\u8FB0',
+	'\uF972':     '\u6C88',
+	'\uF973':     '\u62FE',
+	'\uF974':     '\u82E5',
+	'\uF975':     '\u63A0',
+	'\uF976':     '\u7565',
+	'\uF977':     '\u4EAE',
+	'\uF978':     '\u5169',
+	'\uF979':     '\u51C9',
+	'\uF97A':     '\u6881',
+	'\uF97B':     '\u7CE7',
+	'\uF97C':     '\u826F',
+	'\uF97D':     '\u8AD2',
+	'\uF97E':     '\u91CF',
+	'\uF97F':     '\u52F5',
+	'\uF980':     '\u5442',
+	'\uF981':     '\u5973',
+	'\uF982':     '\u5EEC',
+	'\uF983':     '\u65C5',
+	'\uF984':     '\u6FFE',
+	'\uF985':     '\u792A',
+	'\uF986':     '\u95AD',
+	'\uF987':     '\u9A6A',
+	'\uF988':     '\u9E97',
+	'\uF989':     '\u9ECE',
+	'\uF98A':     '\u529B',
+	'\uF98B':     '\u66C6',
+	'\uF98C':     '\u6B77',
+	'\uF98D':     '\u8F62',
+	'\uF98E':     '\u5E74',
+	'\uF98F':     '\u6190',
+	'\uF990':     '\u6200',
+	'\uF991':     '\u649A',
+	'\uF992':     '\u6F23',
+	'\uF993':     '\u7149',
+	'\uF994':     '\u7489',
+	'\uF995':     '\u79CA',
+	'\uF996':     '\u7DF4',
+	'\uF997':     '\u806F',
+	'\uF998':     '\u8F26',
+	'\uF999':     '\u84EE',
+	'\uF99A':     '\u9023',
+	'\uF99B':     '\u934A',
+	'\uF99C':     '\u5217',
+	'\uF99D':     '\u52A3',
+	'\uF99E':     '\u54BD',
+	'\uF99F':     '\u70C8',
+	'\uF9A0':     '\u88C2',
+	'\uF9A1':     '\u8AAA',
+	'\uF9A2':     '\u5EC9',
+	'\uF9A3':     '\u5FF5',
+	'\uF9A4':     '\u637B',
+	'\uF9A5':     '\u6BAE',
+	'\uF9A6':     '\u7C3E',
+	'\uF9A7':     '\u7375',
+	'\uF9A8':     '\u4EE4',
+	'\uF9A9':     '\u56F9',
+	'\uF9AA':     '\u5BE7',
+	'\uF9AB':     '\u5DBA',
+	'\uF9AC':     '\u601C',
+	'\uF9AD':     '\u73B2',
+	'\uF9AE':     '\u7469',
+	'\uF9AF':     '\u7F9A',
+	'\uF9B0':     '\u8046',
+	'\uF9B1':     '\u9234',
+	'\uF9B2':     '\u96F6',
+	'\uF9B3':     '\u9748',
+	'\uF9B4':     '\u9818',
+	'\uF9B5':     '\u4F8B',
+	'\uF9B6':     '\u79AE',
+	'\uF9B7':     '\u91B4',
+	'\uF9B8':     '\u96B8',
+	'\uF9B9':     '\u60E1',
+	'\uF9BA':     '\u4E86',
+	'\uF9BB':     '\u50DA',
+	'\uF9BC':     '\u5BEE',
+	'\uF9BD':     '\u5C3F',
+	'\uF9BE':     '\u6599',
+	'\uF9BF':     '\u6A02',
+	'\uF9C0':     '\u71CE',
+	'\uF9C1':     '\u7642',
+	'\uF9C2':     '\u84FC',
+	'\uF9C3':     '\u907C',
+	'\uF9C4':     '\u9F8D',
+	'\uF9C5':     '\u6688',
+	'\uF9C6':     '\u962E',
+	'\uF9C7':     '\u5289',
+	'\uF9C8':     '\u677B',
+	'\uF9C9':     '\u67F3',
+	'\uF9CA':     '\u6D41',
+	'\uF9CB':     '\u6E9C',
+	'\uF9CC':     '\u7409',
+	'\uF9CD':     '\u7559',
+	'\uF9CE':     '\u786B',
+	'\uF9CF':     '\u7D10',
+	'\uF9D0':     '\u985E',
+	'\uF9D1':     '\u516D',
+	'\uF9D2':     '\u622E',
+	'\uF9D3':     '\u9678',
+	'\uF9D4':     '\u502B',
+	'\uF9D5':     '\u5D19',
+	'\uF9D6':     '\u6DEA',
+	'\uF9D7':     '\u8F2A',
+	'\uF9D8':     '\u5F8B',
+	'\uF9D9':     '\u6144',
+	'\uF9DA':     '\u6817',
+	'\uF9DB':     '\u7387',
+	'\uF9DC':     '\u9686',
+	'\uF9DD':     '\u5229',
+	'\uF9DE':     '\u540F',
+	'\uF9DF':     '\u5C65',
+	'\uF9E0':     '\u6613',
+	'\uF9E1':     '\u674E',
+	'\uF9E2':     '\u68A8',
+	'\uF9E3':     '\u6CE5',
+	'\uF9E4':     '\u7406',
+	'\uF9E5':     '\u75E2',
+	'\uF9E6':     '\u7F79',
+	'\uF9E7':     '\u88CF',
+	'\uF9E8':     '\u88E1',
+	'\uF9E9':     '\u91CC',
+	'\uF9EA':     '\u96E2',
+	'\uF9EB':     '\u533F',
+	'\uF9EC':     '\u6EBA',
+	'\uF9ED':     '\u541D',
+	'\uF9EE':     '\u71D0',
+	'\uF9EF':     '\u7498',
+	'\uF9F0':     '\u85FA',
+	'\uF9F1':     '\u96A3',
+	'\uF9F2':     '\u9C57',
+	'\uF9F3':     '\u9E9F',
+	'\uF9F4':     '\u6797',
+	'\uF9F5':     '\u6DCB',
+	'\uF9F6':     '\u81E8',
+	'\uF9F7':     '\u7ACB',
+	'\uF9F8':     '\u7B20',
+	'\uF9F9':     '\u7C92',
+	'\uF9FA':     '\u72C0',
+	'\uF9FB':     '\u7099',
+	'\uF9FC':     '\u8B58',
+	'\uF9FD':     '\u4EC0',
+	'\uF9FE':     '\u8336',
+	'\uF9FF':     '\u523A',
+	'\uFA00':     '\u5207',
+	'\uFA01':     '\u5EA6',
+	'\uFA02':     '\u62D3',
+	'\uFA03':     '\u7CD6',
+	'\uFA04':     '\u5B85',
+	'\uFA05':     '\u6D1E',
+	'\uFA06':     '\u66B4',
+	'\uFA07':     '\u8F3B',
+	'\uFA08':     '\u884C',
+	'\uFA09':     '\u964D',
+	'\uFA0A':     '\u898B',
+	'\uFA0B':     '\u5ED3',
+	'\uFA0C':     '\u5140',
+	'\uFA0D':     '\u55C0',
+	'\uFA10':     '\u585A',
+	'\uFA12':     '\u6674',
+	'\uFA15':     '\u51DE',
+	'\uFA16':     '\u732A',
+	'\uFA17':     '\u76CA',
+	'\uFA18':     '\u793C',
+	'\uFA19':     '\u795E',
+	'\uFA1A':     '\u7965',
+	'\uFA1B':     '\u798F',
+	'\uFA1C':     '\u9756',
+	'\uFA1D':     '\u7CBE',
+	'\uFA1E':     '\u7FBD',
+	'\uFA20':     '\u8612',
+	'\uFA22':     '\u8AF8',
+	'\uFA25':     '\u9038',
+	'\uFA26':     '\u90FD',
+	'\uFA2A':     '\u98EF',
+	'\uFA2B':     '\u98FC',
+	'\uFA2C':     '\u9928',
+	'\uFA2D':     '\u9DB4',
+	'\uFA2E':     '\u90DE',
+	'\uFA2F':     '\u96B7',
+	'\uFA30':     '\u4FAE',
+	'\uFA31':     '\u50E7',
+	'\uFA32':     '\u514D',
+	'\uFA33':     '\u52C9',
+	'\uFA34':     '\u52E4',
+	'\uFA35':     '\u5351',
+	'\uFA36':     '\u559D',
+	'\uFA37':     '\u5606',
+	'\uFA38':     '\u5668',
+	'\uFA39':     '\u5840',
+	'\uFA3A':     '\u58A8',
+	'\uFA3B':     '\u5C64',
+	'\uFA3C':     '\u5C6E',
+	'\uFA3D':     '\u6094',
+	'\uFA3E':     '\u6168',
+	'\uFA3F':     '\u618E',
+	'\uFA40':     '\u61F2',
+	'\uFA41':     '\u654F',
+	'\uFA42':     '\u65E2',
+	'\uFA43':     '\u6691',
+	'\uFA44':     '\u6885',
+	'\uFA45':     '\u6D77',
+	'\uFA46':     '\u6E1A',
+	'\uFA47':     '\u6F22',
+	'\uFA48':     '\u716E',
+	'\uFA49':     '\u722B',
+	'\uFA4A':     '\u7422',
+	'\uFA4B':     '\u7891',
+	'\uFA4C':     '\u793E',
+	'\uFA4D':     '\u7949',
+	'\uFA4E':     '\u7948',
+	'\uFA4F':     '\u7950',
+	'\uFA50':     '\u7956',
+	'\uFA51':     '\u795D',
+	'\uFA52':     '\u798D',
+	'\uFA53':     '\u798E',
+	'\uFA54':     '\u7A40',
+	'\uFA55':     '\u7A81',
+	'\uFA56':     '\u7BC0',
+	'\uFA57':     '\u7DF4',
+	'\uFA58':     '\u7E09',
+	'\uFA59':     '\u7E41',
+	'\uFA5A':     '\u7F72',
+	'\uFA5B':     '\u8005',
+	'\uFA5C':     '\u81ED',
+	'\uFA5D':     '\u8279',
+	'\uFA5E':     '\u8279',
+	'\uFA5F':     '\u8457',
+	'\uFA60':     '\u8910',
+	'\uFA61':     '\u8996',
+	'\uFA62':     '\u8B01',
+	'\uFA63':     '\u8B39',
+	'\uFA64':     '\u8CD3',
+	'\uFA65':     '\u8D08',
+	'\uFA66':     '\u8FB6',
+	'\uFA67':     '\u9038',
+	'\uFA68':     '\u96E3',
+	'\uFA69':     '\u97FF',
+	'\uFA6A':     '\u983B',
+	'\uFA6B':     '\u6075',
+	'\uFA6C':     '\U000242EE',
+	'\uFA6D':     '\u8218',
+	'\uFA70':     '\u4E26',
+	'\uFA71':     '\u51B5',
+	'\uFA72':     '\u5168',
+	'\uFA73':     '\u4F80',
+	'\uFA74':     '\u5145',
+	'\uFA75':     '\u5180',
+	'\uFA76':     '\u52C7',
+	'\uFA77':     '\u52FA',
+	'\uFA78':     '\u559D',
+	'\uFA79':     '\u5555',
+	'\uFA7A':     '\u5599',
+	'\uFA7B':     '\u55E2',
+	'\uFA7C':     '\u585A',
+	'\uFA7D':     '\u58B3',
+	'\uFA7E':     '\u5944',
+	'\uFA7F':     '\u5954',
+	'\uFA80':     '\u5A62',
+	'\uFA81':     '\u5B28',
+	'\uFA82':     '\u5ED2',
+	'\uFA83':     '\u5ED9',
+	'\uFA84':     '\u5F69',
+	'\uFA85':     '\u5FAD',
+	'\uFA86':     '\u60D8',
+	'\uFA87':     '\u614E',
+	'\uFA88':     '\u6108',
+	'\uFA89':     '\u618E',
+	'\uFA8A':     '\u6160',
+	'\uFA8B':     '\u61F2',
+	'\uFA8C':     '\u6234',
+	'\uFA8D':     '\u63C4',
+	'\uFA8E':     '\u641C',
+	'\uFA8F':     '\u6452',
+	'\uFA90':     '\u6556',
+	'\uFA91':     '\u6674',
+	'\uFA92':     '\u6717',
+	'\uFA93':     '\u671B',
+	'\uFA94':     '\u6756',
+	'\uFA95':     '\u6B79',
+	'\uFA96':     '\u6BBA',
+	'\uFA97':     '\u6D41',
+	'\uFA98':     '\u6EDB',
+	'\uFA99':     '\u6ECB',
+	'\uFA9A':     '\u6F22',
+	'\uFA9B':     '\u701E',
+	'\uFA9C':     '\u716E',
+	'\uFA9D':     '\u77A7',
+	'\uFA9E':     '\u7235',
+	'\uFA9F':     '\u72AF',
+	'\uFAA0':     '\u732A',
+	'\uFAA1':     '\u7471',
+	'\uFAA2':     '\u7506',
+	'\uFAA3':     '\u753B',
+	'\uFAA4':     '\u761D',
+	'\uFAA5':     '\u761F',
+	'\uFAA6':     '\u76CA',
+	'\uFAA7':     '\u76DB',
+	'\uFAA8':     '\u76F4',
+	'\uFAA9':     '\u774A',
+	'\uFAAA':     '\u7740',
+	'\uFAAB':     '\u78CC',
+	'\uFAAC':     '\u7AB1',
+	'\uFAAD':     '\u7BC0',
+	'\uFAAE':     '\u7C7B',
+	'\uFAAF':     '\u7D5B',
+	'\uFAB0':     '\u7DF4',
+	'\uFAB1':     '\u7F3E',
+	'\uFAB2':     '\u8005',
+	'\uFAB3':     '\u8352',
+	'\uFAB4':     '\u83EF',
+	'\uFAB5':     '\u8779',
+	'\uFAB6':     '\u8941',
+	'\uFAB7':     '\u8986',
+	'\uFAB8':     '\u8996',
+	'\uFAB9':     '\u8ABF',
+	'\uFABA':     '\u8AF8',
+	'\uFABB':     '\u8ACB',
+	'\uFABC':     '\u8B01',
+	'\uFABD':     '\u8AFE',
+	'\uFABE':     '\u8AED',
+	'\uFABF':     '\u8B39',
+	'\uFAC0':     '\u8B8A',
+	'\uFAC1':     '\u8D08',
+	'\uFAC2':     '\u8F38',
+	'\uFAC3':     '\u9072',
+	'\uFAC4':     '\u9199',
+	'\uFAC5':     '\u9276',
+	'\uFAC6':     '\u967C',
+	'\uFAC7':     '\u96E3',
+	'\uFAC8':     '\u9756',
+	'\uFAC9':     '\u97DB',
+	'\uFACA':     '\u97FF',
+	'\uFACB':     '\u980B',
+	'\uFACC':     '\u983B',
+	'\uFACD':     '\u9B12',
+	'\uFACE':     '\u9F9C',
+	'\uFACF':     '\U0002284A',
+	'\uFAD0':     '\U00022844',
+	'\uFAD1':     '\U000233D5',
+	'\uFAD2':     '\u3B9D',
+	'\uFAD3':     '\u4018',
+	'\uFAD4':     '\u4039',
+	'\uFAD5':     '\U00025249',
+	'\uFAD6':     '\U00025CD0',
+	'\uFAD7':     '\U00027ED3',
+	'\uFAD8':     '\u9F43',
+	'\uFAD9':     '\u9F8E',
+	'\U0002F800': '\u4E3D',
+	'\U0002F801': '\u4E38',
+	'\U0002F802': '\u4E41',
+	'\U0002F803': '\U00020122',
+	'\U0002F804': '\u4F60',
+	'\U0002F805': '\u4FAE',
+	'\U0002F806': '\u4FBB',
+	'\U0002F807': '\u5002',
+	'\U0002F808': '\u507A',
+	'\U0002F809': '\u5099',
+	'\U0002F80A': '\u50E7',
+	'\U0002F80B': '\u50CF',
+	'\U0002F80C': '\u349E',
+	'\U0002F80D': '\U0002063A',
+	'\U0002F80E': '\u514D',
+	'\U0002F80F': '\u5154',
+	'\U0002F810': '\u5164',
+	'\U0002F811': '\u5177',
+	'\U0002F812': '\U0002051C',
+	'\U0002F813': '\u34B9',
+	'\U0002F814': '\u5167',
+	'\U0002F815': '\u518D',
+	'\U0002F816': '\U0002054B',
+	'\U0002F817': '\u5197',
+	'\U0002F818': '\u51A4',
+	'\U0002F819': '\u4ECC',
+	'\U0002F81A': '\u51AC',
+	'\U0002F81B': '\u51B5',
+	'\U0002F81C': '\U000291DF',
+	'\U0002F81D': '\u51F5',
+	'\U0002F81E': '\u5203',
+	'\U0002F81F': '\u34DF',
+	'\U0002F820': '\u523B',
+	'\U0002F821': '\u5246',
+	'\U0002F822': '\u5272',
+	'\U0002F823': '\u5277',
+	'\U0002F824': '\u3515',
+	'\U0002F825': '\u52C7',
+	'\U0002F826': '\u52C9',
+	'\U0002F827': '\u52E4',
+	'\U0002F828': '\u52FA',
+	'\U0002F829': '\u5305',
+	'\U0002F82A': '\u5306',
+	'\U0002F82B': '\u5317',
+	'\U0002F82C': '\u5349',
+	'\U0002F82D': '\u5351',
+	'\U0002F82E': '\u535A',
+	'\U0002F82F': '\u5373',
+	'\U0002F830': '\u537D',
+	'\U0002F831': '\u537F',
+	'\U0002F832': '\u537F',
+	'\U0002F833': '\u537F',
+	'\U0002F834': '\U00020A2C',
+	'\U0002F835': '\u7070',
+	'\U0002F836': '\u53CA',
+	'\U0002F837': '\u53DF',
+	'\U0002F838': '\U00020B63',
+	'\U0002F839': '\u53EB',
+	'\U0002F83A': '\u53F1',
+	'\U0002F83B': '\u5406',
+	'\U0002F83C': '\u549E',
+	'\U0002F83D': '\u5438',
+	'\U0002F83E': '\u5448',
+	'\U0002F83F': '\u5468',
+	'\U0002F840': '\u54A2',
+	'\U0002F841': '\u54F6',
+	'\U0002F842': '\u5510',
+	'\U0002F843': '\u5553',
+	'\U0002F844': '\u5563',
+	'\U0002F845': '\u5584',
+	'\U0002F846': '\u5584',
+	'\U0002F847': '\u5599',
+	'\U0002F848': '\u55AB',
+	'\U0002F849': '\u55B3',
+	'\U0002F84A': '\u55C2',
+	'\U0002F84B': '\u5716',
+	'\U0002F84C': '\u5606',
+	'\U0002F84D': '\u5717',
+	'\U0002F84E': '\u5651',
+	'\U0002F84F': '\u5674',
+	'\U0002F850': '\u5207',
+	'\U0002F851': '\u58EE',
+	'\U0002F852': '\u57CE',
+	'\U0002F853': '\u57F4',
+	'\U0002F854': '\u580D',
+	'\U0002F855': '\u578B',
+	'\U0002F856': '\u5832',
+	'\U0002F857': '\u5831',
+	'\U0002F858': '\u58AC',
+	'\U0002F859': '\U000214E4',
+	'\U0002F85A': '\u58F2',
+	'\U0002F85B': '\u58F7',
+	'\U0002F85C': '\u5906',
+	'\U0002F85D': '\u591A',
+	'\U0002F85E': '\u5922',
+	'\U0002F85F': '\u5962',
+	'\U0002F860': '\U000216A8',
+	'\U0002F861': '\U000216EA',
+	'\U0002F862': '\u59EC',
+	'\U0002F863': '\u5A1B',
+	'\U0002F864': '\u5A27',
+	'\U0002F865': '\u59D8',
+	'\U0002F866': '\u5A66',
+	'\U0002F867': '\u36EE',
+	'\U0002F868': '\u36FC',
+	'\U0002F869': '\u5B08',
+	'\U0002F86A': '\u5B3E',
+	'\U0002F86B': '\u5B3E',
+	'\U0002F86C': '\U000219C8',
+	'\U0002F86D': '\u5BC3',
+	'\U0002F86E': '\u5BD8',
+	'\U0002F86F': '\u5BE7',
+	'\U0002F870': '\u5BF3',
+	'\U0002F871': '\U00021B18',
+	'\U0002F872': '\u5BFF',
+	'\U0002F873': '\u5C06',
+	'\U0002F874': '\u5F53',
+	'\U0002F875': '\u5C22',
+	'\U0002F876': '\u3781',
+	'\U0002F877': '\u5C60',
+	'\U0002F878': '\u5C6E',
+	'\U0002F879': '\u5CC0',
+	'\U0002F87A': '\u5C8D',
+	'\U0002F87B': '\U00021DE4',
+	'\U0002F87C': '\u5D43',
+	'\U0002F87D': '\U00021DE6',
+	'\U0002F87E': '\u5D6E',
+	'\U0002F87F': '\u5D6B',
+	'\U0002F880': '\u5D7C',
+	'\U0002F881': '\u5DE1',
+	'\U0002F882': '\u5DE2',
+	'\U0002F883': '\u382F',
+	'\U0002F884': '\u5DFD',
+	'\U0002F885': '\u5E28',
+	'\U0002F886': '\u5E3D',
+	'\U0002F887': '\u5E69',
+	'\U0002F888': '\u3862',
+	'\U0002F889': '\U00022183',
+	'\U0002F88A': '\u387C',
+	'\U0002F88B': '\u5EB0',
+	'\U0002F88C': '\u5EB3',
+	'\U0002F88D': '\u5EB6',
+	'\U0002F88E': '\u5ECA',
+	'\U0002F88F': '\U0002A392',
+	'\U0002F890': '\u5EFE',
+	'\U0002F891': '\U00022331',
+	'\U0002F892': '\U00022331',
+	'\U0002F893': '\u8201',
+	'\U0002F894': '\u5F22',
+	'\U0002F895': '\u5F22',
+	'\U0002F896': '\u38C7',
+	'\U0002F897': '\U000232B8',
+	'\U0002F898': '\U000261DA',
+	'\U0002F899': '\u5F62',
+	'\U0002F89A': '\u5F6B',
+	'\U0002F89B': '\u38E3',
+	'\U0002F89C': '\u5F9A',
+	'\U0002F89D': '\u5FCD',
+	'\U0002F89E': '\u5FD7',
+	'\U0002F89F': '\u5FF9',
+	'\U0002F8A0': '\u6081',
+	'\U0002F8A1': '\u393A',
+	'\U0002F8A2': '\u391C',
+	'\U0002F8A3': '\u6094',
+	'\U0002F8A4': '\U000226D4',
+	'\U0002F8A5': '\u60C7',
+	'\U0002F8A6': '\u6148',
+	'\U0002F8A7': '\u614C',
+	'\U0002F8A8': '\u614E',
+	'\U0002F8A9': '\u614C',
+	'\U0002F8AA': '\u617A',
+	'\U0002F8AB': '\u618E',
+	'\U0002F8AC': '\u61B2',
+	'\U0002F8AD': '\u61A4',
+	'\U0002F8AE': '\u61AF',
+	'\U0002F8AF': '\u61DE',
+	'\U0002F8B0': '\u61F2',
+	'\U0002F8B1': '\u61F6',
+	'\U0002F8B2': '\u6210',
+	'\U0002F8B3': '\u621B',
+	'\U0002F8B4': '\u625D',
+	'\U0002F8B5': '\u62B1',
+	'\U0002F8B6': '\u62D4',
+	'\U0002F8B7': '\u6350',
+	'\U0002F8B8': '\U00022B0C',
+	'\U0002F8B9': '\u633D',
+	'\U0002F8BA': '\u62FC',
+	'\U0002F8BB': '\u6368',
+	'\U0002F8BC': '\u6383',
+	'\U0002F8BD': '\u63E4',
+	'\U0002F8BE': '\U00022BF1',
+	'\U0002F8BF': '\u6422',
+	'\U0002F8C0': '\u63C5',
+	'\U0002F8C1': '\u63A9',
+	'\U0002F8C2': '\u3A2E',
+	'\U0002F8C3': '\u6469',
+	'\U0002F8C4': '\u647E',
+	'\U0002F8C5': '\u649D',
+	'\U0002F8C6': '\u6477',
+	'\U0002F8C7': '\u3A6C',
+	'\U0002F8C8': '\u654F',
+	'\U0002F8C9': '\u656C',
+	'\U0002F8CA': '\U0002300A',
+	'\U0002F8CB': '\u65E3',
+	'\U0002F8CC': '\u66F8',
+	'\U0002F8CD': '\u6649',
+	'\U0002F8CE': '\u3B19',
+	'\U0002F8CF': '\u6691',
+	'\U0002F8D0': '\u3B08',
+	'\U0002F8D1': '\u3AE4',
+	'\U0002F8D2': '\u5192',
+	'\U0002F8D3': '\u5195',
+	'\U0002F8D4': '\u6700',
+	'\U0002F8D5': '\u669C',
+	'\U0002F8D6': '\u80AD',
+	'\U0002F8D7': '\u43D9',
+	'\U0002F8D8': '\u6717',
+	'\U0002F8D9': '\u671B',
+	'\U0002F8DA': '\u6721',
+	'\U0002F8DB': '\u675E',
+	'\U0002F8DC': '\u6753',
+	'\U0002F8DD': '\U000233C3',
+	'\U0002F8DE': '\u3B49',
+	'\U0002F8DF': '\u67FA',
+	'\U0002F8E0': '\u6785',
+	'\U0002F8E1': '\u6852',
+	'\U0002F8E2': '\u6885',
+	'\U0002F8E3': '\U0002346D',
+	'\U0002F8E4': '\u688E',
+	'\U0002F8E5': '\u681F',
+	'\U0002F8E6': '\u6914',
+	'\U0002F8E7': '\u3B9D',
+	'\U0002F8E8': '\u6942',
+	'\U0002F8E9': '\u69A3',
+	'\U0002F8EA': '\u69EA',
+	'\U0002F8EB': '\u6AA8',
+	'\U0002F8EC': '\U000236A3',
+	'\U0002F8ED': '\u6ADB',
+	'\U0002F8EE': '\u3C18',
+	'\U0002F8EF': '\u6B21',
+	'\U0002F8F0': '\U000238A7',
+	'\U0002F8F1': '\u6B54',
+	'\U0002F8F2': '\u3C4E',
+	'\U0002F8F3': '\u6B72',
+	'\U0002F8F4': '\u6B9F',
+	'\U0002F8F5': '\u6BBA',
+	'\U0002F8F6': '\u6BBB',
+	'\U0002F8F7': '\U00023A8D',
+	'\U0002F8F8': '\U00021D0B',
+	'\U0002F8F9': '\U00023AFA',
+	'\U0002F8FA': '\u6C4E',
+	'\U0002F8FB': '\U00023CBC',
+	'\U0002F8FC': '\u6CBF',
+	'\U0002F8FD': '\u6CCD',
+	'\U0002F8FE': '\u6C67',
+	'\U0002F8FF': '\u6D16',
+	'\U0002F900': '\u6D3E',
+	'\U0002F901': '\u6D77',
+	'\U0002F902': '\u6D41',
+	'\U0002F903': '\u6D69',
+	'\U0002F904': '\u6D78',
+	'\U0002F905': '\u6D85',
+	'\U0002F906': '\U00023D1E',
+	'\U0002F907': '\u6D34',
+	'\U0002F908': '\u6E2F',
+	'\U0002F909': '\u6E6E',
+	'\U0002F90A': '\u3D33',
+	'\U0002F90B': '\u6ECB',
+	'\U0002F90C': '\u6EC7',
+	'\U0002F90D': '\U00023ED1',
+	'\U0002F90E': '\u6DF9',
+	'\U0002F90F': '\u6F6E',
+	'\U0002F910': '\U00023F5E',
+	'\U0002F911': '\U00023F8E',
+	'\U0002F912': '\u6FC6',
+	'\U0002F913': '\u7039',
+	'\U0002F914': '\u701E',
+	'\U0002F915': '\u701B',
+	'\U0002F916': '\u3D96',
+	'\U0002F917': '\u704A',
+	'\U0002F918': '\u707D',
+	'\U0002F919': '\u7077',
+	'\U0002F91A': '\u70AD',
+	'\U0002F91B': '\U00020525',
+	'\U0002F91C': '\u7145',
+	'\U0002F91D': '\U00024263',
+	'\U0002F91E': '\u719C',
+	'\U0002F91F': '\U000243AB',
+	'\U0002F920': '\u7228',
+	'\U0002F921': '\u7235',
+	'\U0002F922': '\u7250',
+	'\U0002F923': '\U00024608',
+	'\U0002F924': '\u7280',
+	'\U0002F925': '\u7295',
+	'\U0002F926': '\U00024735',
+	'\U0002F927': '\U00024814',
+	'\U0002F928': '\u737A',
+	'\U0002F929': '\u738B',
+	'\U0002F92A': '\u3EAC',
+	'\U0002F92B': '\u73A5',
+	'\U0002F92C': '\u3EB8',
+	'\U0002F92D': '\u3EB8',
+	'\U0002F92E': '\u7447',
+	'\U0002F92F': '\u745C',
+	'\U0002F930': '\u7471',
+	'\U0002F931': '\u7485',
+	'\U0002F932': '\u74CA',
+	'\U0002F933': '\u3F1B',
+	'\U0002F934': '\u7524',
+	'\U0002F935': '\U00024C36',
+	'\U0002F936': '\u753E',
+	'\U0002F937': '\U00024C92',
+	'\U0002F938': '\u7570',
+	'\U0002F939': '\U0002219F',
+	'\U0002F93A': '\u7610',
+	'\U0002F93B': '\U00024FA1',
+	'\U0002F93C': '\U00024FB8',
+	'\U0002F93D': '\U00025044',
+	'\U0002F93E': '\u3FFC',
+	'\U0002F93F': '\u4008',
+	'\U0002F940': '\u76F4',
+	'\U0002F941': '\U000250F3',
+	'\U0002F942': '\U000250F2',
+	'\U0002F943': '\U00025119',
+	'\U0002F944': '\U00025133',
+	'\U0002F945': '\u771E',
+	'\U0002F946': '\u771F',
+	'\U0002F947': '\u771F',
+	'\U0002F948': '\u774A',
+	'\U0002F949': '\u4039',
+	'\U0002F94A': '\u778B',
+	'\U0002F94B': '\u4046',
+	'\U0002F94C': '\u4096',
+	'\U0002F94D': '\U0002541D',
+	'\U0002F94E': '\u784E',
+	'\U0002F94F': '\u788C',
+	'\U0002F950': '\u78CC',
+	'\U0002F951': '\u40E3',
+	'\U0002F952': '\U00025626',
+	'\U0002F953': '\u7956',
+	'\U0002F954': '\U0002569A',
+	'\U0002F955': '\U000256C5',
+	'\U0002F956': '\u798F',
+	'\U0002F957': '\u79EB',
+	'\U0002F958': '\u412F',
+	'\U0002F959': '\u7A40',
+	'\U0002F95A': '\u7A4A',
+	'\U0002F95B': '\u7A4F',
+	'\U0002F95C': '\U0002597C',
+	'\U0002F95D': '\U00025AA7',
+	'\U0002F95E': '\U00025AA7',
+	'\U0002F95F': '\u7AEE',
+	'\U0002F960': '\u4202',
+	'\U0002F961': '\U00025BAB',
+	'\U0002F962': '\u7BC6',
+	'\U0002F963': '\u7BC9',
+	'\U0002F964': '\u4227',
+	'\U0002F965': '\U00025C80',
+	'\U0002F966': '\u7CD2',
+	'\U0002F967': '\u42A0',
+	'\U0002F968': '\u7CE8',
+	'\U0002F969': '\u7CE3',
+	'\U0002F96A': '\u7D00',
+	'\U0002F96B': '\U00025F86',
+	'\U0002F96C': '\u7D63',
+	'\U0002F96D': '\u4301',
+	'\U0002F96E': '\u7DC7',
+	'\U0002F96F': '\u7E02',
+	'\U0002F970': '\u7E45',
+	'\U0002F971': '\u4334',
+	'\U0002F972': '\U00026228',
+	'\U0002F973': '\U00026247',
+	'\U0002F974': '\u4359',
+	'\U0002F975': '\U000262D9',
+	'\U0002F976': '\u7F7A',
+	'\U0002F977': '\U0002633E',
+	'\U0002F978': '\u7F95',
+	'\U0002F979': '\u7FFA',
+	'\U0002F97A': '\u8005',
+	'\U0002F97B': '\U000264DA',
+	'\U0002F97C': '\U00026523',
+	'\U0002F97D': '\u8060',
+	'\U0002F97E': '\U000265A8',
+	'\U0002F97F': '\u8070',
+	'\U0002F980': '\U0002335F',
+	'\U0002F981': '\u43D5',
+	'\U0002F982': '\u80B2',
+	'\U0002F983': '\u8103',
+	'\U0002F984': '\u440B',
+	'\U0002F985': '\u813E',
+	'\U0002F986': '\u5AB5',
+	'\U0002F987': '\U000267A7',
+	'\U0002F988': '\U000267B5',
+	'\U0002F989': '\U00023393',
+	'\U0002F98A': '\U0002339C',
+	'\U0002F98B': '\u8201',
+	'\U0002F98C': '\u8204',
+	'\U0002F98D': '\u8F9E',
+	'\U0002F98E': '\u446B',
+	'\U0002F98F': '\u8291',
+	'\U0002F990': '\u828B',
+	'\U0002F991': '\u829D',
+	'\U0002F992': '\u52B3',
+	'\U0002F993': '\u82B1',
+	'\U0002F994': '\u82B3',
+	'\U0002F995': '\u82BD',
+	'\U0002F996': '\u82E6',
+	'\U0002F997': '\U00026B3C',
+	'\U0002F998': '\u82E5',
+	'\U0002F999': '\u831D',
+	'\U0002F99A': '\u8363',
+	'\U0002F99B': '\u83AD',
+	'\U0002F99C': '\u8323',
+	'\U0002F99D': '\u83BD',
+	'\U0002F99E': '\u83E7',
+	'\U0002F99F': '\u8457',
+	'\U0002F9A0': '\u8353',
+	'\U0002F9A1': '\u83CA',
+	'\U0002F9A2': '\u83CC',
+	'\U0002F9A3': '\u83DC',
+	'\U0002F9A4': '\U00026C36',
+	'\U0002F9A5': '\U00026D6B',
+	'\U0002F9A6': '\U00026CD5',
+	'\U0002F9A7': '\u452B',
+	'\U0002F9A8': '\u84F1',
+	'\U0002F9A9': '\u84F3',
+	'\U0002F9AA': '\u8516',
+	'\U0002F9AB': '\U000273CA',
+	'\U0002F9AC': '\u8564',
+	'\U0002F9AD': '\U00026F2C',
+	'\U0002F9AE': '\u455D',
+	'\U0002F9AF': '\u4561',
+	'\U0002F9B0': '\U00026FB1',
+	'\U0002F9B1': '\U000270D2',
+	'\U0002F9B2': '\u456B',
+	'\U0002F9B3': '\u8650',
+	'\U0002F9B4': '\u865C',
+	'\U0002F9B5': '\u8667',
+	'\U0002F9B6': '\u8669',
+	'\U0002F9B7': '\u86A9',
+	'\U0002F9B8': '\u8688',
+	'\U0002F9B9': '\u870E',
+	'\U0002F9BA': '\u86E2',
+	'\U0002F9BB': '\u8779',
+	'\U0002F9BC': '\u8728',
+	'\U0002F9BD': '\u876B',
+	'\U0002F9BE': '\u8786',
+	'\U0002F9BF': '\u45D7',
+	'\U0002F9C0': '\u87E1',
+	'\U0002F9C1': '\u8801',
+	'\U0002F9C2': '\u45F9',
+	'\U0002F9C3': '\u8860',
+	'\U0002F9C4': '\u8863',
+	'\U0002F9C5': '\U00027667',
+	'\U0002F9C6': '\u88D7',
+	'\U0002F9C7': '\u88DE',
+	'\U0002F9C8': '\u4635',
+	'\U0002F9C9': '\u88FA',
+	'\U0002F9CA': '\u34BB',
+	'\U0002F9CB': '\U000278AE',
+	'\U0002F9CC': '\U00027966',
+	'\U0002F9CD': '\u46BE',
+	'\U0002F9CE': '\u46C7',
+	'\U0002F9CF': '\u8AA0',
+	'\U0002F9D0': '\u8AED',
+	'\U0002F9D1': '\u8B8A',
+	'\U0002F9D2': '\u8C55',
+	'\U0002F9D3': '\U00027CA8',
+	'\U0002F9D4': '\u8CAB',
+	'\U0002F9D5': '\u8CC1',
+	'\U0002F9D6': '\u8D1B',
+	'\U0002F9D7': '\u8D77',
+	'\U0002F9D8': '\U00027F2F',
+	'\U0002F9D9': '\U00020804',
+	'\U0002F9DA': '\u8DCB',
+	'\U0002F9DB': '\u8DBC',
+	'\U0002F9DC': '\u8DF0',
+	'\U0002F9DD': '\U000208DE',
+	'\U0002F9DE': '\u8ED4',
+	'\U0002F9DF': '\u8F38',
+	'\U0002F9E0': '\U000285D2',
+	'\U0002F9E1': '\U000285ED',
+	'\U0002F9E2': '\u9094',
+	'\U0002F9E3': '\u90F1',
+	'\U0002F9E4': '\u9111',
+	'\U0002F9E5': '\U0002872E',
+	'\U0002F9E6': '\u911B',
+	'\U0002F9E7': '\u9238',
+	'\U0002F9E8': '\u92D7',
+	'\U0002F9E9': '\u92D8',
+	'\U0002F9EA': '\u927C',
+	'\U0002F9EB': '\u93F9',
+	'\U0002F9EC': '\u9415',
+	'\U0002F9ED': '\U00028BFA',
+	'\U0002F9EE': '\u958B',
+	'\U0002F9EF': '\u4995',
+	'\U0002F9F0': '\u95B7',
+	'\U0002F9F1': '\U00028D77',
+	'\U0002F9F2': '\u49E6',
+	'\U0002F9F3': '\u96C3',
+	'\U0002F9F4': '\u5DB2',
+	'\U0002F9F5': '\u9723',
+	'\U0002F9F6': '\U00029145',
+	'\U0002F9F7': '\U0002921A',
+	'\U0002F9F8': '\u4A6E',
+	'\U0002F9F9': '\u4A76',
+	'\U0002F9FA': '\u97E0',
+	'\U0002F9FB': '\U0002940A',
+	'\U0002F9FC': '\u4AB2',
+	'\U0002F9FD': '\U00029496',
+	'\U0002F9FE': '\u980B',
+	'\U0002F9FF': '\u980B',
+	'\U0002FA00': '\u9829',
+	'\U0002FA01': '\U000295B6',
+	'\U0002FA02': '\u98E2',
+	'\U0002FA03': '\u4B33',
+	'\U0002FA04': '\u9929',
+	'\U0002FA05': '\u99A7',
+	'\U0002FA06': '\u99C2',
+	'\U0002FA07': '\u99FE',
+	'\U0002FA08': '\u4BCE',
+	'\U0002FA09': '\U00029B30',
+	'\U0002FA0A': '\u9B12',
+	'\U0002FA0B': '\u9C40',
+	'\U0002FA0C': '\u9CFD',
+	'\U0002FA0D': '\u4CCE',
+	'\U0002FA0E': '\u4CED',
+	'\U0002FA0F': '\u9D67',
+	'\U0002FA10': '\U0002A0CE',
+	'\U0002FA11': '\u4CF8',
+	'\U0002FA12': '\U0002A105',
+	'\U0002FA13': '\U0002A20E',
+	'\U0002FA14': '\U0002A291',
+	'\U0002FA15': '\u9EBB',
+	'\U0002FA16': '\u4D56',
+	'\U0002FA17': '\u9EF9',
+	'\U0002FA18': '\u9EFE',
+	'\U0002FA19': '\u9F05',
+	'\U0002FA1A': '\u9F0F',
+	'\U0002FA1B': '\u9F16',
+	'\U0002FA1C': '\u9F3B',
+	'\U0002FA1D': '\U0002A600',
+}
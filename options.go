@@ -6,7 +6,7 @@ import (
 )
 
 // ConvertOptions describes options for [Convert].
-type ConvertOptions int
+type ConvertOptions int64
 
 const (
 	// HalfwidthToWide converts characters in halfwidth forms
@@ -36,31 +36,17 @@ const (
 	//  - [CompatKeepHalfwidthHangul]
 	//  - [CompatKeepHalfwidthSymbols]
 	HalfwidthToWide ConvertOptions = 1 << iota
-	// FullwidthToNarrow converts characters in fullwidth forms
+	// FullwidthAlnumToNarrow converts fullwidth digits and Latin letters
 	// to their ordinary, narrow versions.
 	//
-	// The characters having East_Asian_Width property value of
-	// F (East Asian Fullwidth) are converted.
-	// That is:
-	//
-	//  - U+FF01 FULLWIDTH EXCLAMATION MARK (！) to U+FF60 FULLWIDTH RIGHT WHITE PARENTHESIS (｠)
-	//  - U+FFE0 FULLWIDTH CENT SIGN (￠) to U+FFE6 FULLWIDTH WON SIGN (￦)
-	//
-	// The conversion is roughly equivalent to NFKC but with some differences:
+	// The following characters are converted:
 	//
-	//  - U+FFE3 FULLWIDTH MACRON (￣) is not fully normalized and instead
-	//    converted to U+00AF MACRON (¯).
+	//  - U+FF10 FULLWIDTH DIGIT ZERO (０) to U+FF19 FULLWIDTH DIGIT NINE (９)
+	//  - U+FF21 FULLWIDTH LATIN CAPITAL LETTER A (Ａ) to U+FF3A FULLWIDTH LATIN CAPITAL LETTER Z (Ｚ)
+	//  - U+FF41 FULLWIDTH LATIN SMALL LETTER A (ａ) to U+FF5A FULLWIDTH LATIN SMALL LETTER Z (ｚ)
 	//
-	// The following compat flags affect the behavior of this transformation:
-	//
-	//  - [CompatQuotes]
-	//  - [CompatMinus]
-	//  - [CompatOverline]
-	//  - [CompatCurrency]
-	//  - [CompatBrackets]
-	//  - [CompatKeepSpaces]
-	//  - [CompatDoubleSpaces]
-	FullwidthToNarrow
+	// It is a part of [FullwidthToNarrow].
+	FullwidthAlnumToNarrow
 	// KatakanaToHiragana converts katakana to hiragana.
 	//
 	// Consider it transformation from Script=Katakana to Script=Hiragana,
@@ -79,10 +65,7 @@ const (
 	//
 	// Those characters are not converted:
 	//
-	//  - U+30FF KATAKANA DIGRAPH KOTO (ヿ)
 	//  - U+31F0 KATAKANA LETTER SMALL KU (ㇰ) to U+31FF KATAKANA LETTER SMALL RO (ㇿ)
-	//  - U+32D0 CIRCLED KATAKANA A (㋐) to U+32FE CIRCLED KATAKANA WO (㋾)
-	//  - U+3300 SQUARE APAATO (㌀) to U+3357 SQUARE WATTO (㍗)
 	//  - U+1AFF0 KATAKANA LETTER MINNAN TONE-2 (𚿰) to U+1AFF3 KATAKANA LETTER MINNAN TONE-5 (𚿳)
 	//  - U+1AFF5 KATAKANA LETTER MINNAN TONE-7 (𚿵) to U+1AFFB KATAKANA LETTER MINNAN NASALIZED TONE-8 (𚿻)
 	//  - U+1B000 KATAKANA LETTER ARCHAIC E (𛀀)
@@ -94,6 +77,13 @@ const (
 	//  - U+FF66 HALFWIDTH KATAKANA LETTER WO (ｦ) to U+FF6F HALFWIDTH KATAKANA LETTER SMALL TU (ｯ)
 	//  - U+FF71 HALFWIDTH KATAKANA LETTER A (ｱ) to U+FF9D HALFWIDTH KATAKANA LETTER N (ﾝ)
 	//
+	// You need [ExpandKanaCompatibility] to convert them to hiragana:
+	//
+	//  - U+30FF KATAKANA DIGRAPH KOTO (ヿ)
+	//  - U+32D0 CIRCLED KATAKANA A (㋐) to U+32FE CIRCLED KATAKANA WO (㋾)
+	//  - U+3300 SQUARE APAATO (㌀) to U+3357 SQUARE WATTO (㍗)
+	//  - U+1F201 SQUARED KATAKANA KOKO (🈁) to U+1F202 SQUARED KATAKANA SA (🈂)
+	//
 	// The following compat flags affect the behavior of this transformation:
 	//
 	//  - [CompatKanaRestriction]
@@ -112,8 +102,11 @@ const (
 	//
 	// Those characters are not converted:
 	//
-	//  - U+309F HIRAGANA DIGRAPH YORI (ゟ)
 	//  - U+1B001 HIRAGANA LETTER ARCHAIC YE (𛀁) to U+1B11F HIRAGANA LETTER ARCHAIC WU (𛄟)
+	//
+	// You need [ExpandKanaCompatibility] to convert them to katakana:
+	//
+	//  - U+309F HIRAGANA DIGRAPH YORI (ゟ)
 	//  - U+1F200 SQUARE HIRAGANA HOKA (🈀)
 	//
 	// The following compat flags affect the behavior of this transformation:
@@ -130,6 +123,8 @@ const (
 	//
 	// If you want to normalize between fullwidth and halfwidth katakana,
 	// you should use [HalfwidthToWide] instead.
+	// If you need halfwidth katakana for other systems,
+	// you should use [WideKatakanaToHalfwidth] instead.
 	//
 	// The following characters are converted:
 	//
@@ -348,11 +343,640 @@ const (
 	//  - U+1B151 HIRAGANA LETTER SMALL WE (𛅑)
 	//  - U+1B152 HIRAGANA LETTER SMALL WO (𛅒)
 	CompatKanaRestriction
+	// RomajiToHiragana converts romaji (Latin letters) to hiragana,
+	// following the rules commonly used by Japanese input methods.
+	//
+	// The conversion is case-insensitive and is applied to
+	// U+0041 LATIN CAPITAL LETTER A (A) to U+005A LATIN CAPITAL LETTER Z (Z),
+	// U+0061 LATIN SMALL LETTER A (a) to U+007A LATIN SMALL LETTER Z (z),
+	// U+0027 APOSTROPHE ('), and U+002D HYPHEN-MINUS (-).
+	// Fullwidth letters are also converted if [FullwidthToNarrow] is given,
+	// as romaji conversion is applied after the width conversion.
+	//
+	// The following rules are applied:
+	//
+	//  - Syllables such as "ka", "shi", "tsu", and "kyo" are converted to
+	//    the corresponding kana. Both Hepburn-style ("shi", "chi", "fu", "ja")
+	//    and Kunrei-style ("si", "ti", "hu", "zya") spellings are accepted.
+	//  - Extended syllables such as "fa", "thi", "dhu", "twu", "va", and "wi"
+	//    are converted to kana with small vowels (ふぁ, てぃ, でゅ, とぅ, ゔぁ, うぃ).
+	//  - Small kana are written with a leading "x" or "l",
+	//    such as "xa" (ぁ), "lya" (ゃ), "xtu" or "ltsu" (っ), "xwa" (ゎ),
+	//    and "xka" (ゕ).
+	//  - A doubled consonant other than "n" is converted to
+	//    a small tsu (っ) followed by the consonant, such as "kk" → "っk".
+	//    Likewise, "tch" is converted to "っch".
+	//  - "nn", "n'", and "xn" are converted to ん. A single "n" is
+	//    also converted to ん unless it is followed by a vowel or "y".
+	//  - "-" is converted to U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー).
+	//
+	// Letters that do not form a syllable are kept as they are.
+	// Letters followed by combining marks (e.g. U+0300 COMBINING GRAVE ACCENT)
+	// are also kept, so that a letter with a diacritic is kept as is
+	// in both NFC and NFD.
+	//
+	// Since the result is hiragana, it is further converted to katakana
+	// if [HiraganaToKatakana] is also given.
+	RomajiToHiragana
+	// RomajiToKatakana converts romaji (Latin letters) to katakana.
+	//
+	// The rules are the same as [RomajiToHiragana], except that
+	// the result is written in katakana.
+	// If both [RomajiToHiragana] and RomajiToKatakana are given,
+	// [RomajiToHiragana] takes precedence.
+	RomajiToKatakana
+	// SmallKanaToLarge converts small kana to their full-size letters.
+	//
+	// This is useful for fuzzy matching, where キャ and キヤ
+	// should be considered equal.
+	//
+	// The following characters are converted:
+	//
+	//  - U+3041 HIRAGANA LETTER SMALL A (ぁ) to U+3049 HIRAGANA LETTER SMALL O (ぉ)
+	//  - U+3063 HIRAGANA LETTER SMALL TU (っ)
+	//  - U+3083 HIRAGANA LETTER SMALL YA (ゃ) to U+3087 HIRAGANA LETTER SMALL YO (ょ)
+	//  - U+308E HIRAGANA LETTER SMALL WA (ゎ)
+	//  - U+3095 HIRAGANA LETTER SMALL KA (ゕ) to U+3096 HIRAGANA LETTER SMALL KE (ゖ)
+	//  - U+30A1 KATAKANA LETTER SMALL A (ァ) to U+30A9 KATAKANA LETTER SMALL O (ォ)
+	//  - U+30C3 KATAKANA LETTER SMALL TU (ッ)
+	//  - U+30E3 KATAKANA LETTER SMALL YA (ャ) to U+30E7 KATAKANA LETTER SMALL YO (ョ)
+	//  - U+30EE KATAKANA LETTER SMALL WA (ヮ)
+	//  - U+30F5 KATAKANA LETTER SMALL KA (ヵ) to U+30F6 KATAKANA LETTER SMALL KE (ヶ)
+	//  - U+31F0 KATAKANA LETTER SMALL KU (ㇰ) to U+31FF KATAKANA LETTER SMALL RO (ㇿ)
+	//  - U+FF67 HALFWIDTH KATAKANA LETTER SMALL A (ｧ) to U+FF6F HALFWIDTH KATAKANA LETTER SMALL TU (ｯ)
+	//  - U+1B132 HIRAGANA LETTER SMALL KO (𛄲)
+	//  - U+1B150 HIRAGANA LETTER SMALL WI (𛅐) to U+1B152 HIRAGANA LETTER SMALL WO (𛅒)
+	//  - U+1B155 KATAKANA LETTER SMALL KO (𛅕)
+	//  - U+1B164 KATAKANA LETTER SMALL WI (𛅤) to U+1B167 KATAKANA LETTER SMALL N (𛅧)
+	//
+	// Only the size is changed; for example, halfwidth small kana are
+	// converted to halfwidth large kana unless [HalfwidthToWide] is also given.
+	// The result is further converted by [KatakanaToHiragana]
+	// and [HiraganaToKatakana] if given.
+	SmallKanaToLarge
+	// StripVoicedSoundMarks removes voiced and semi-voiced sound marks
+	// (dakuten and handakuten) from kana.
+	//
+	// This is useful for lenient matching, where バ, ハ, パ and ﾊﾞ
+	// should be considered equal.
+	//
+	// Voiced and semi-voiced kana are converted to their base letters,
+	// including the following characters:
+	//
+	//  - U+3094 HIRAGANA LETTER VU (ゔ) to U+3046 HIRAGANA LETTER U (う)
+	//  - U+309E HIRAGANA VOICED ITERATION MARK (ゞ) to U+309D HIRAGANA ITERATION MARK (ゝ)
+	//  - U+30F4 KATAKANA LETTER VU (ヴ) to U+30A6 KATAKANA LETTER U (ウ)
+	//  - U+30F7 KATAKANA LETTER VA (ヷ) to U+30FA KATAKANA LETTER VO (ヺ),
+	//    to U+30EF KATAKANA LETTER WA (ワ) to U+30F2 KATAKANA LETTER WO (ヲ)
+	//  - U+30FE KATAKANA VOICED ITERATION MARK (ヾ) to U+30FD KATAKANA ITERATION MARK (ヽ)
+	//
+	// and the following characters are removed:
+	//
+	//  - U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK to U+309C KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK (゜)
+	//  - U+FF9E HALFWIDTH KATAKANA VOICED SOUND MARK (ﾞ) to U+FF9F HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK (ﾟ)
+	//
+	// If [HalfwidthToWide] is also given, halfwidth katakana are
+	// composed with the following sound marks before they are removed.
+	// The result is further converted by [KatakanaToHiragana]
+	// and [HiraganaToKatakana] if given.
+	StripVoicedSoundMarks
+	// ComposeVoicedSoundMarks composes kana followed by
+	// voiced or semi-voiced sound marks into the precomposed kana.
+	//
+	// The following sound marks are composed with the preceding kana
+	// if the precomposed kana exists:
+	//
+	//  - U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK
+	//  - U+309A COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	//  - U+309B KATAKANA-HIRAGANA VOICED SOUND MARK (゛)
+	//  - U+309C KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK (゜)
+	//
+	// For example, か゛, か followed by U+3099 and ハ゜ are converted to
+	// が, が and パ, respectively. The precomposed kana include
+	// U+3094 HIRAGANA LETTER VU (ゔ), U+30F4 KATAKANA LETTER VU (ヴ),
+	// U+30F7 KATAKANA LETTER VA (ヷ) to U+30FA KATAKANA LETTER VO (ヺ),
+	// and the voiced iteration marks (ゞ and ヾ).
+	//
+	// Otherwise, U+309B and U+309C are converted to
+	// U+3099 and U+309A, respectively.
+	//
+	// If [HalfwidthToWide] is also given, the sound marks are composed
+	// with the result of the conversion. For example, ｳ followed by
+	// U+3099 is converted to ヴ, and so is ﾜﾞ even with
+	// [CompatVoicedKanaRestriction].
+	ComposeVoicedSoundMarks
+	// ExpandIterationMarks replaces kana iteration marks
+	// with the kana they repeat.
+	//
+	// The following characters are replaced with the preceding kana:
+	//
+	//  - U+309D HIRAGANA ITERATION MARK (ゝ)
+	//  - U+30FD KATAKANA ITERATION MARK (ヽ)
+	//
+	// and the following characters are replaced with
+	// the voiced version of the preceding kana:
+	//
+	//  - U+309E HIRAGANA VOICED ITERATION MARK (ゞ)
+	//  - U+30FE KATAKANA VOICED ITERATION MARK (ヾ)
+	//
+	// For example, いすゞ is converted to いすず, and がゝ to がか.
+	// The iteration marks are kept as is if they do not follow kana.
+	//
+	// The preceding kana is looked up after the other conversions
+	// such as [HalfwidthToWide] and [RomajiToHiragana], and the result
+	// is further converted by [KatakanaToHiragana] and [HiraganaToKatakana]
+	// if given.
+	ExpandIterationMarks
+	// ExpandVerticalIterationMarks replaces the vertical kana repeat marks
+	// with the two kana they repeat.
+	//
+	// The following characters and sequences are replaced with
+	// the preceding two kana:
+	//
+	//  - U+3031 VERTICAL KANA REPEAT MARK (〱)
+	//  - U+3033 VERTICAL KANA REPEAT MARK UPPER HALF (〳) followed by
+	//    U+3035 VERTICAL KANA REPEAT MARK LOWER HALF (〵)
+	//
+	// and the following characters and sequences are replaced with
+	// the preceding two kana, the first of which is voiced:
+	//
+	//  - U+3032 VERTICAL KANA REPEAT WITH VOICED SOUND MARK (〲)
+	//  - U+3034 VERTICAL KANA REPEAT WITH VOICED SOUND MARK UPPER HALF (〴) followed by
+	//    U+3035 VERTICAL KANA REPEAT MARK LOWER HALF (〵)
+	//
+	// For example, いろ〱 is converted to いろいろ, and ひろ〴〵 to ひろびろ.
+	//
+	// Since the marks may repeat more than two kana in practice,
+	// this option is not included in [ExpandIterationMarks].
+	ExpandVerticalIterationMarks
+	// ExpandKanjiIterationMarks replaces the ideographic iteration marks
+	// with the preceding kanji.
+	//
+	// The following characters are converted:
+	//
+	//  - U+3005 IDEOGRAPHIC ITERATION MARK (々)
+	//  - U+303B VERTICAL IDEOGRAPHIC ITERATION MARK (〻)
+	//
+	// For example, 時々 is converted to 時時.
+	// The iteration marks are kept as is if they do not follow kanji.
+	//
+	// Since 々 is usually considered a part of the word,
+	// this option is not included in [ExpandIterationMarks].
+	ExpandKanjiIterationMarks
+	// ExpandProlongedSoundMark replaces U+30FC KATAKANA-HIRAGANA
+	// PROLONGED SOUND MARK (ー) with the vowel of the preceding kana.
+	//
+	// The vowel is written in the same script as the preceding kana.
+	// For example, ラーメン is converted to ラアメン, コーヒー to コオヒイ,
+	// and すーじー to すうじい. Small kana are treated in the same way
+	// as the full-size ones, so that キャー is converted to キャア.
+	//
+	// The following kana are treated specially:
+	//
+	//  - After U+3093 HIRAGANA LETTER N (ん) and U+30F3 KATAKANA LETTER N (ン),
+	//    the mark is replaced with the same kana (e.g. ンー to ンン).
+	//  - After U+3063 HIRAGANA LETTER SMALL TU (っ) and
+	//    U+30C3 KATAKANA LETTER SMALL TU (ッ), the mark is kept as is.
+	//
+	// The mark is kept as is after non-kana characters.
+	// Consecutive marks are replaced with the same vowel (e.g. ラーー to ラアア).
+	//
+	// U+FF70 HALFWIDTH KATAKANA-HIRAGANA PROLONGED SOUND MARK (ｰ) is replaced
+	// as well if [HalfwidthToWide] is also given.
+	ExpandProlongedSoundMark
+	// ExpandKanaCompatibility expands the compatibility characters
+	// made of kana to the sequences of ordinary kana.
+	//
+	// The following characters are expanded to
+	// their compatibility decompositions:
+	//
+	//  - U+309F HIRAGANA DIGRAPH YORI (ゟ) → より
+	//  - U+30FF KATAKANA DIGRAPH KOTO (ヿ) → コト
+	//  - U+32D0 CIRCLED KATAKANA A (㋐) to U+32FE CIRCLED KATAKANA WO (㋾)
+	//    (e.g. ㋐ → ア)
+	//  - U+3300 SQUARE APAATO (㌀) to U+3357 SQUARE WATTO (㍗)
+	//    (e.g. ㌀ → アパート, ㌔ → キロ)
+	//  - U+1F200 SQUARE HIRAGANA HOKA (🈀) → ほか
+	//  - U+1F201 SQUARED KATAKANA KOKO (🈁) → ココ
+	//  - U+1F202 SQUARED KATAKANA SA (🈂) → サ
+	//
+	// The expanded kana are further converted by the other options
+	// such as [KatakanaToHiragana] and [HiraganaToKatakana]
+	// (e.g. ㌔ → きろ with [KatakanaToHiragana]).
+	ExpandKanaCompatibility
+	// NormalizeEnclosedAlphanumerics converts enclosed alphanumerics
+	// to their compatibility decompositions.
+	//
+	// The following characters are converted:
+	//
+	//  - U+2460 CIRCLED DIGIT ONE (①) to U+24EA CIRCLED DIGIT ZERO (⓪)
+	//    (e.g. ① → 1, ⑴ → (1), ⒈ → 1., Ⓐ → A)
+	//  - U+3251 CIRCLED NUMBER TWENTY ONE (㉑) to U+325F CIRCLED NUMBER THIRTY FIVE (㉟)
+	//  - U+32B1 CIRCLED NUMBER THIRTY SIX (㊱) to U+32BF CIRCLED NUMBER FIFTY (㊿)
+	//  - U+1F100 DIGIT ZERO FULL STOP (🄀) to U+1F10A DIGIT NINE COMMA (🄊)
+	//  - U+1F110 PARENTHESIZED LATIN CAPITAL LETTER A (🄐) to U+1F12E CIRCLED WZ (🄮)
+	//  - U+1F130 SQUARED LATIN CAPITAL LETTER A (🄰) to U+1F149 SQUARED LATIN CAPITAL LETTER Z (🅉)
+	//
+	// The negative and double circled numbers, which do not have
+	// compatibility decompositions, are kept as is.
+	NormalizeEnclosedAlphanumerics
+	// NormalizeSuperscripts converts superscript and subscript characters
+	// to their ordinary forms.
+	//
+	// The characters whose compatibility decompositions are
+	// tagged <super> or <sub> are converted (e.g. ² → 2, ⁿ → n, ₂ → 2, ㆒ → 一),
+	// except for U+2120 SERVICE MARK (℠) and U+2122 TRADE MARK SIGN (™),
+	// and the raised signs handled by [NormalizeSquaredLatinAbbreviations].
+	NormalizeSuperscripts
+	// NormalizeCJKCompatibilityIdeographs converts CJK compatibility
+	// ideographs to the unified ideographs they are canonically
+	// equivalent to.
+	//
+	// The following characters are converted:
+	//
+	//  - U+F900 CJK COMPATIBILITY IDEOGRAPH-F900 (豈) to U+FAD9 CJK COMPATIBILITY IDEOGRAPH-FAD9 (龎)
+	//    (e.g. 豈 → 豈)
+	//  - U+2F800 CJK COMPATIBILITY IDEOGRAPH-2F800 (丽) to U+2FA1D CJK COMPATIBILITY IDEOGRAPH-2FA1D (𪘀)
+	//
+	// The characters in the ranges that are actually unified ideographs,
+	// such as U+FA0E CJK COMPATIBILITY IDEOGRAPH-FA0E (﨎), are kept as is.
+	NormalizeCJKCompatibilityIdeographs
+	// NormalizeKangxiRadicals converts Kangxi radicals and
+	// CJK radicals to the unified ideographs they are compatible with.
+	//
+	// The following characters are converted:
+	//
+	//  - U+2E9F CJK RADICAL MOTHER (⺟) → U+6BCD (母)
+	//  - U+2EF3 CJK RADICAL C-SIMPLIFIED TURTLE (⻳) → U+9F9F (龟)
+	//  - U+2F00 KANGXI RADICAL ONE (⼀) to U+2FD5 KANGXI RADICAL FLUTE (⿕)
+	//    (e.g. ⼀ → 一)
+	NormalizeKangxiRadicals
+	// NormalizeSquaredLatinAbbreviations converts squared Latin
+	// abbreviations, mostly units, to their compatibility decompositions.
+	//
+	// The following characters are converted:
+	//
+	//  - U+3250 PARTNERSHIP SIGN (㉐)
+	//  - U+32CC SQUARE HG (㋌) to U+32CF LIMITED LIABILITY SIGN (㋏)
+	//  - U+3371 SQUARE HPA (㍱) to U+337A SQUARE IU (㍺)
+	//  - U+3380 SQUARE PA AMPS (㎀) to U+33DF SQUARE A OVER M (㏟)
+	//    (e.g. ㎏ → kg, ㎡ → m2, ㏂ → a.m.)
+	//  - U+33FF SQUARE GAL (㏿)
+	//  - U+1F14A SQUARED HV (🅊) to U+1F14F SQUARED WC (🅏)
+	//  - U+1F16A RAISED MC SIGN (🅪) to U+1F16C RAISED MR SIGN (🅬)
+	//  - U+1F190 SQUARE DJ (🆐)
+	//
+	// Some of them are converted to non-ASCII characters
+	// (e.g. ㎍ → μg, ㏀ → kΩ, ㎧ → m∕s).
+	NormalizeSquaredLatinAbbreviations
+	// NormalizeVerticalForms converts presentation forms for vertical text
+	// to their horizontal counterparts.
+	//
+	// Unlike NFKC, the characters are converted to the wide forms,
+	// so that the result has the same width as the input
+	// (e.g. ︵ → （, ︐ → ，, ﹁ → 「).
+	// Combine with [FullwidthToNarrow] to get the narrow forms.
+	//
+	// The following characters are converted:
+	//
+	//  - U+FE10 PRESENTATION FORM FOR VERTICAL COMMA (︐) to
+	//    U+FE19 PRESENTATION FORM FOR VERTICAL HORIZONTAL ELLIPSIS (︙)
+	//  - U+FE30 PRESENTATION FORM FOR VERTICAL TWO DOT LEADER (︰) to
+	//    U+FE44 PRESENTATION FORM FOR VERTICAL RIGHT WHITE CORNER BRACKET (﹄)
+	//  - U+FE47 PRESENTATION FORM FOR VERTICAL LEFT SQUARE BRACKET (﹇) to
+	//    U+FE48 PRESENTATION FORM FOR VERTICAL RIGHT SQUARE BRACKET (﹈)
+	NormalizeVerticalForms
+	// NormalizeDashes converts dashes, hyphens, and minus signs
+	// to U+002D HYPHEN-MINUS (-).
+	//
+	// The following characters are converted:
+	//
+	//  - U+2010 HYPHEN (‐) to U+2015 HORIZONTAL BAR (―)
+	//  - U+2212 MINUS SIGN (−)
+	//  - U+FE58 SMALL EM DASH (﹘)
+	//  - U+FE63 SMALL HYPHEN-MINUS (﹣)
+	//  - U+FF0D FULLWIDTH HYPHEN-MINUS (－)
+	//
+	// Additionally, U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー) and
+	// U+FF70 HALFWIDTH KATAKANA-HIRAGANA PROLONGED SOUND MARK (ｰ) are converted
+	// when they are between digits (0 to 9 or ０ to ９) in the input,
+	// which is common in phone numbers and postal codes (e.g. 03ー1234ー5678).
+	//
+	// The target is always U+002D HYPHEN-MINUS (-) and cannot be chosen.
+	// It is converted to U+FF0D FULLWIDTH HYPHEN-MINUS (－) afterwards
+	// only if [NarrowPunctuationToFullwidth] (a part of [NarrowToFullwidth])
+	// is also given.
+	//
+	// Unlike [CompatMinus], this is not a compatibility option,
+	// and wins over it.
+	NormalizeDashes
+	// NormalizeTildes converts tildes and wave dashes
+	// to U+301C WAVE DASH (〜).
+	//
+	// The following characters are converted:
+	//
+	//  - U+2053 SWUNG DASH (⁓)
+	//  - U+223C TILDE OPERATOR (∼)
+	//  - U+301C WAVE DASH (〜)
+	//  - U+3030 WAVY DASH (〰)
+	//  - U+FF5E FULLWIDTH TILDE (～)
+	//
+	// If [FullwidthSymbolsToNarrow] (a part of [FullwidthToNarrow]) is also given,
+	// they are converted to U+007E TILDE (~) instead, regardless of [CompatOverline].
+	//
+	// U+007E TILDE (~) itself is kept as is without [FullwidthSymbolsToNarrow],
+	// as it is often a part of ASCII text such as URLs.
+	NormalizeTildes
+	// NormalizeQuotes converts quotation marks and primes
+	// to U+0027 APOSTROPHE (') and U+0022 QUOTATION MARK (").
+	//
+	// Specifically, the following transformations are applied:
+	//
+	//  - U+2018 LEFT SINGLE QUOTATION MARK (‘) → U+0027 APOSTROPHE (')
+	//  - U+2019 RIGHT SINGLE QUOTATION MARK (’) → U+0027 APOSTROPHE (')
+	//  - U+201A SINGLE LOW-9 QUOTATION MARK (‚) → U+0027 APOSTROPHE (')
+	//  - U+201B SINGLE HIGH-REVERSED-9 QUOTATION MARK (‛) → U+0027 APOSTROPHE (')
+	//  - U+2032 PRIME (′) → U+0027 APOSTROPHE (')
+	//  - U+2035 REVERSED PRIME (‵) → U+0027 APOSTROPHE (')
+	//  - U+FF07 FULLWIDTH APOSTROPHE (＇) → U+0027 APOSTROPHE (')
+	//  - U+201C LEFT DOUBLE QUOTATION MARK (“) → U+0022 QUOTATION MARK (")
+	//  - U+201D RIGHT DOUBLE QUOTATION MARK (”) → U+0022 QUOTATION MARK (")
+	//  - U+201E DOUBLE LOW-9 QUOTATION MARK („) → U+0022 QUOTATION MARK (")
+	//  - U+201F DOUBLE HIGH-REVERSED-9 QUOTATION MARK (‟) → U+0022 QUOTATION MARK (")
+	//  - U+2033 DOUBLE PRIME (″) → U+0022 QUOTATION MARK (")
+	//  - U+2036 REVERSED DOUBLE PRIME (‶) → U+0022 QUOTATION MARK (")
+	//  - U+301D REVERSED DOUBLE PRIME QUOTATION MARK (〝) to
+	//    U+301F LOW DOUBLE PRIME QUOTATION MARK (〟) → U+0022 QUOTATION MARK (")
+	//  - U+FF02 FULLWIDTH QUOTATION MARK (＂) → U+0022 QUOTATION MARK (")
+	//
+	// Unlike [CompatQuotes], it does not depend on the width options,
+	// and wins over [CompatQuotes] when both are given.
+	//
+	// The following flags affect the behavior of this transformation:
+	//
+	//  - [CornerBracketsToQuotes]
+	NormalizeQuotes
+	// CornerBracketsToQuotes is an option for [NormalizeQuotes]
+	// to convert CJK corner brackets as quotation marks.
+	//
+	// Specifically, the following transformations are additionally applied
+	// in [NormalizeQuotes]:
+	//
+	//  - U+300C LEFT CORNER BRACKET (「) → U+0022 QUOTATION MARK (")
+	//  - U+300D RIGHT CORNER BRACKET (」) → U+0022 QUOTATION MARK (")
+	//  - U+300E LEFT WHITE CORNER BRACKET (『) → U+0027 APOSTROPHE (')
+	//  - U+300F RIGHT WHITE CORNER BRACKET (』) → U+0027 APOSTROPHE (')
+	//  - U+FF62 HALFWIDTH LEFT CORNER BRACKET (｢) → U+0022 QUOTATION MARK (")
+	//  - U+FF63 HALFWIDTH RIGHT CORNER BRACKET (｣) → U+0022 QUOTATION MARK (")
+	CornerBracketsToQuotes
+	// FullwidthSymbolsToNarrow converts fullwidth symbols
+	// (General_Category=S) to their ordinary, narrow versions.
+	//
+	// The following characters are converted:
+	//
+	//  - U+FF04 FULLWIDTH DOLLAR SIGN (＄)
+	//  - U+FF0B FULLWIDTH PLUS SIGN (＋)
+	//  - U+FF1C FULLWIDTH LESS-THAN SIGN (＜) to U+FF1E FULLWIDTH GREATER-THAN SIGN (＞)
+	//  - U+FF3E FULLWIDTH CIRCUMFLEX ACCENT (＾)
+	//  - U+FF40 FULLWIDTH GRAVE ACCENT (｀)
+	//  - U+FF5C FULLWIDTH VERTICAL LINE (｜)
+	//  - U+FF5E FULLWIDTH TILDE (～)
+	//  - U+FFE0 FULLWIDTH CENT SIGN (￠) to U+FFE6 FULLWIDTH WON SIGN (￦)
+	//
+	// It is a part of [FullwidthToNarrow].
+	// The symbols converted by the compat flags, such as U+2212 MINUS SIGN (−)
+	// for [CompatMinus], are also subject to this option.
+	FullwidthSymbolsToNarrow
+	// FullwidthPunctuationToNarrow converts fullwidth punctuation
+	// (General_Category=P) to their ordinary, narrow versions.
+	//
+	// The following characters are converted:
+	//
+	//  - U+FF01 FULLWIDTH EXCLAMATION MARK (！) to U+FF03 FULLWIDTH NUMBER SIGN (＃)
+	//  - U+FF05 FULLWIDTH PERCENT SIGN (％) to U+FF0A FULLWIDTH ASTERISK (＊)
+	//  - U+FF0C FULLWIDTH COMMA (，) to U+FF0F FULLWIDTH SOLIDUS (／)
+	//  - U+FF1A FULLWIDTH COLON (：) to U+FF1B FULLWIDTH SEMICOLON (；)
+	//  - U+FF1F FULLWIDTH QUESTION MARK (？) to U+FF20 FULLWIDTH COMMERCIAL AT (＠)
+	//  - U+FF3B FULLWIDTH LEFT SQUARE BRACKET (［) to U+FF3D FULLWIDTH RIGHT SQUARE BRACKET (］)
+	//  - U+FF3F FULLWIDTH LOW LINE (＿)
+	//  - U+FF5B FULLWIDTH LEFT CURLY BRACKET (｛)
+	//  - U+FF5D FULLWIDTH RIGHT CURLY BRACKET (｝)
+	//  - U+FF5F FULLWIDTH LEFT WHITE PARENTHESIS (｟) to U+FF60 FULLWIDTH RIGHT WHITE PARENTHESIS (｠)
+	//
+	// It is a part of [FullwidthToNarrow].
+	// The punctuation converted by the compat flags, such as
+	// U+2014 EM DASH (—) for [CompatMinus], is also subject to this option.
+	//
+	// Note that U+3001 IDEOGRAPHIC COMMA (、) and U+3002 IDEOGRAPHIC FULL STOP (。)
+	// are not fullwidth forms and are never converted.
+	FullwidthPunctuationToNarrow
+	// FullwidthSpaceToNarrow converts U+3000 IDEOGRAPHIC SPACE (　)
+	// to U+0020 SPACE ( ).
+	//
+	// It is a part of [FullwidthToNarrow].
+	//
+	// The following compat flags affect the behavior of this transformation:
+	//
+	//  - [CompatKeepSpaces]
+	//  - [CompatDoubleSpaces]
+	FullwidthSpaceToNarrow
+	// NarrowAlnumToFullwidth converts ASCII digits and Latin letters
+	// to their fullwidth forms.
+	//
+	// It is a part of [NarrowToFullwidth].
+	NarrowAlnumToFullwidth
+	// NarrowSymbolsToFullwidth converts narrow symbols
+	// (General_Category=S) to their fullwidth forms.
+	//
+	// The following characters are converted:
+	//
+	//  - U+0024 DOLLAR SIGN ($)
+	//  - U+002B PLUS SIGN (+)
+	//  - U+003C LESS-THAN SIGN (<) to U+003E GREATER-THAN SIGN (>)
+	//  - U+005E CIRCUMFLEX ACCENT (^)
+	//  - U+0060 GRAVE ACCENT (`)
+	//  - U+007C VERTICAL LINE (|)
+	//  - U+007E TILDE (~)
+	//  - U+00A2 CENT SIGN (¢) → U+FFE0 FULLWIDTH CENT SIGN (￠)
+	//  - U+00A3 POUND SIGN (£) → U+FFE1 FULLWIDTH POUND SIGN (￡)
+	//  - U+00AC NOT SIGN (¬) → U+FFE2 FULLWIDTH NOT SIGN (￢)
+	//  - U+00AF MACRON (¯) → U+FFE3 FULLWIDTH MACRON (￣)
+	//  - U+00A6 BROKEN BAR (¦) → U+FFE4 FULLWIDTH BROKEN BAR (￤)
+	//  - U+00A5 YEN SIGN (¥) → U+FFE5 FULLWIDTH YEN SIGN (￥)
+	//  - U+20A9 WON SIGN (₩) → U+FFE6 FULLWIDTH WON SIGN (￦)
+	//
+	// It is a part of [NarrowToFullwidth].
+	NarrowSymbolsToFullwidth
+	// NarrowPunctuationToFullwidth converts narrow punctuation
+	// (General_Category=P) to their fullwidth forms.
+	//
+	// The following characters are converted:
+	//
+	//  - U+0021 EXCLAMATION MARK (!) to U+0023 NUMBER SIGN (#)
+	//  - U+0025 PERCENT SIGN (%) to U+002A ASTERISK (*)
+	//  - U+002C COMMA (,) to U+002F SOLIDUS (/)
+	//  - U+003A COLON (:) to U+003B SEMICOLON (;)
+	//  - U+003F QUESTION MARK (?) to U+0040 COMMERCIAL AT (@)
+	//  - U+005B LEFT SQUARE BRACKET ([) to U+005D RIGHT SQUARE BRACKET (])
+	//  - U+005F LOW LINE (_)
+	//  - U+007B LEFT CURLY BRACKET ({)
+	//  - U+007D RIGHT CURLY BRACKET (})
+	//  - U+2985 LEFT WHITE PARENTHESIS (⦅) → U+FF5F FULLWIDTH LEFT WHITE PARENTHESIS (｟)
+	//  - U+2986 RIGHT WHITE PARENTHESIS (⦆) → U+FF60 FULLWIDTH RIGHT WHITE PARENTHESIS (｠)
+	//
+	// It is a part of [NarrowToFullwidth].
+	NarrowPunctuationToFullwidth
+	// NarrowSpaceToFullwidth converts U+0020 SPACE ( )
+	// to U+3000 IDEOGRAPHIC SPACE (　).
+	//
+	// It is a part of [NarrowToFullwidth].
+	NarrowSpaceToFullwidth
+	// ComposeHangulJamo composes sequences of Hangul Compatibility Jamo
+	// (U+3131 HANGUL LETTER KIYEOK (ㄱ) to U+3163 HANGUL LETTER I (ㅣ))
+	// into precomposed Hangul syllables
+	// (U+AC00 HANGUL SYLLABLE GA (가) to U+D7A3 HANGUL SYLLABLE HIH (힣)).
+	//
+	// Unlike the conjoining jamo, the compatibility jamo do not distinguish
+	// leading consonants from trailing ones, so the roles are guessed:
+	//
+	//  - A consonant directly followed by a vowel is a leading consonant.
+	//  - A consonant following a composed vowel is a trailing consonant,
+	//    unless it is followed by a vowel.
+	//
+	// Jamo that do not fit into the pattern, such as lone vowels,
+	// consecutive consonants at the start of a syllable, and
+	// U+3164 HANGUL FILLER, are kept as is.
+	// For example, ㅎㅏㄴㄱㅡㄹ is converted to 한글, and ㄱㅏㄴㅏ to 가나,
+	// while ㅋㅋ and ㄳㅏ are kept as is.
+	//
+	// If [HalfwidthToWide] is also given, the jamo are composed
+	// with the result of the conversion. For example, ￂﾡ is
+	// converted to 가, unless [CompatKeepHalfwidthHangul] is given.
+	ComposeHangulJamo
+	// WideKatakanaToHalfwidth converts katakana to their halfwidth forms.
+	//
+	// Unlike [CompatWideKatakanaToHalfwidth], it converts every katakana
+	// representable in halfwidth forms, and only katakana:
+	//
+	//  - U+309B KATAKANA-HIRAGANA VOICED SOUND MARK (゛) to U+309C KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK (゜)
+	//  - U+30A1 KATAKANA LETTER SMALL A (ァ) to U+30ED KATAKANA LETTER RO (ロ)
+	//  - U+30EF KATAKANA LETTER WA (ワ)
+	//  - U+30F2 KATAKANA LETTER WO (ヲ) to U+30F4 KATAKANA LETTER VU (ヴ)
+	//  - U+30F7 KATAKANA LETTER VA (ヷ)
+	//  - U+30FA KATAKANA LETTER VO (ヺ)
+	//  - U+30FB KATAKANA MIDDLE DOT (・) to U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー)
+	//
+	// The voiced and semi-voiced kana are converted to the base letter
+	// followed by U+FF9E HALFWIDTH KATAKANA VOICED SOUND MARK (ﾞ) or
+	// U+FF9F HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK (ﾟ).
+	// U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK and
+	// U+309A COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK are
+	// converted likewise when they follow a halfwidth katakana,
+	// so that the result does not depend on the normalization form.
+	// The halfwidth and spacing sound marks always result in the halfwidth ones,
+	// even with [HalfwidthToWide] or [ComposeVoicedSoundMarks],
+	// when they do not follow a katakana.
+	// Other characters, such as U+30EE KATAKANA LETTER SMALL WA (ヮ),
+	// U+30F0 KATAKANA LETTER WI (ヰ), and the ideographic punctuation,
+	// are kept as is.
+	//
+	// The conversion is applied after all the other conversions.
+	// Combined with [HiraganaToKatakana], hiragana are also converted:
+	// for example, がっこう is converted to ｶﾞｯｺｳ.
+	WideKatakanaToHalfwidth
 )
 
+// FullwidthToNarrow converts characters in fullwidth forms
+// to their ordinary, narrow versions.
+//
+// The characters having East_Asian_Width property value of
+// F (East Asian Fullwidth) are converted.
+// That is:
+//
+//   - U+3000 IDEOGRAPHIC SPACE (　)
+//   - U+FF01 FULLWIDTH EXCLAMATION MARK (！) to U+FF60 FULLWIDTH RIGHT WHITE PARENTHESIS (｠)
+//   - U+FFE0 FULLWIDTH CENT SIGN (￠) to U+FFE6 FULLWIDTH WON SIGN (￦)
+//
+// It is the union of [FullwidthAlnumToNarrow], [FullwidthSymbolsToNarrow],
+// [FullwidthPunctuationToNarrow], and [FullwidthSpaceToNarrow],
+// which can be given separately to convert only some of the characters.
+//
+// The conversion is roughly equivalent to NFKC but with some differences:
+//
+//   - U+FFE3 FULLWIDTH MACRON (￣) is not fully normalized and instead
+//     converted to U+00AF MACRON (¯).
+//
+// The following compat flags affect the behavior of this transformation:
+//
+//   - [CompatQuotes]
+//   - [CompatMinus]
+//   - [CompatOverline]
+//   - [CompatCurrency]
+//   - [CompatBrackets]
+//   - [CompatKeepSpaces]
+//   - [CompatDoubleSpaces]
+const FullwidthToNarrow = FullwidthAlnumToNarrow | FullwidthSymbolsToNarrow | FullwidthPunctuationToNarrow | FullwidthSpaceToNarrow
+
+// NarrowToFullwidth converts ASCII characters and some other narrow
+// characters to their fullwidth forms. It is the reverse of [FullwidthToNarrow].
+//
+// The following characters are converted:
+//
+//   - U+0020 SPACE ( ) → U+3000 IDEOGRAPHIC SPACE (　)
+//   - U+0021 EXCLAMATION MARK (!) to U+007E TILDE (~) →
+//     U+FF01 FULLWIDTH EXCLAMATION MARK (！) to U+FF5E FULLWIDTH TILDE (～)
+//   - U+00A2 CENT SIGN (¢), U+00A3 POUND SIGN (£), U+00A5 YEN SIGN (¥),
+//     U+00A6 BROKEN BAR (¦), U+00AC NOT SIGN (¬), U+00AF MACRON (¯),
+//     U+20A9 WON SIGN (₩), U+2985 LEFT WHITE PARENTHESIS (⦅), and
+//     U+2986 RIGHT WHITE PARENTHESIS (⦆) → their fullwidth forms
+//
+// It is the union of [NarrowAlnumToFullwidth], [NarrowSymbolsToFullwidth],
+// [NarrowPunctuationToFullwidth], and [NarrowSpaceToFullwidth],
+// which can be given separately to convert only some of the characters.
+// If a class is also converted by [FullwidthToNarrow], [FullwidthToNarrow] wins.
+//
+// The conversion is applied after all the other conversions,
+// so that romaji and the characters produced by the normalization options
+// (e.g. U+002D HYPHEN-MINUS (-) from [NormalizeDashes]) are handled as usual.
+//
+// Like [CompatWideKatakanaToHalfwidth], this transformation newly introduces
+// compatibility characters, and is not stable under canonical equivalence.
+const NarrowToFullwidth = NarrowAlnumToFullwidth | NarrowSymbolsToFullwidth | NarrowPunctuationToFullwidth | NarrowSpaceToFullwidth
+
+// SearchNormalize is a set of options to normalize text for search indexing.
+//
+// In addition to the width normalization by [HalfwidthToWide] and
+// [FullwidthToNarrow], which is roughly equivalent to NFKC,
+// it folds the other compatibility characters commonly found
+// in Japanese text. Unlike NFKC, it does not require golang.org/x/text.
+//
+// Note that kana are not folded between hiragana and katakana;
+// add [KatakanaToHiragana] or [HiraganaToKatakana] if needed.
+const SearchNormalize = HalfwidthToWide | FullwidthToNarrow | ComposeVoicedSoundMarks | ExpandKanaCompatibility |
+	NormalizeEnclosedAlphanumerics | NormalizeSuperscripts | NormalizeCJKCompatibilityIdeographs |
+	NormalizeKangxiRadicals | NormalizeSquaredLatinAbbreviations | NormalizeVerticalForms
+
 func (o ConvertOptions) Normalize() ConvertOptions {
-	if o&FullwidthToNarrow == 0 {
-		o &^= CompatQuotes | CompatBrackets | CompatKeepSpaces | CompatDoubleSpaces
+	if o&(FullwidthSymbolsToNarrow|FullwidthPunctuationToNarrow) == 0 {
+		o &^= CompatQuotes
+	}
+	if o&FullwidthPunctuationToNarrow == 0 {
+		o &^= CompatBrackets
+	}
+	if o&FullwidthSpaceToNarrow == 0 {
+		o &^= CompatKeepSpaces | CompatDoubleSpaces
+	}
+	if o&FullwidthAlnumToNarrow != 0 {
+		o &^= NarrowAlnumToFullwidth
+	}
+	if o&FullwidthSymbolsToNarrow != 0 {
+		o &^= NarrowSymbolsToFullwidth
+	}
+	if o&FullwidthPunctuationToNarrow != 0 {
+		o &^= NarrowPunctuationToFullwidth
+	}
+	if o&FullwidthSpaceToNarrow != 0 {
+		o &^= NarrowSpaceToFullwidth
 	}
 	if o&CompatKeepSpaces != 0 {
 		o &^= CompatDoubleSpaces
@@ -363,6 +987,12 @@ func (o ConvertOptions) Normalize() ConvertOptions {
 	if o&(KatakanaToHiragana|HiraganaToKatakana) == 0 {
 		o &^= CompatKanaRestriction
 	}
+	if o&RomajiToHiragana != 0 {
+		o &^= RomajiToKatakana
+	}
+	if o&NormalizeQuotes == 0 {
+		o &^= CornerBracketsToQuotes
+	}
 	return o
 }
 
@@ -373,6 +1003,17 @@ var flagNames = []struct {
 }{
 	{"HalfwidthToWide", HalfwidthToWide, HalfwidthToWide},
 	{"FullwidthToNarrow", FullwidthToNarrow, FullwidthToNarrow},
+	{"FullwidthAlnumToNarrow", FullwidthAlnumToNarrow, FullwidthAlnumToNarrow},
+	{"FullwidthSymbolsToNarrow", FullwidthSymbolsToNarrow, FullwidthSymbolsToNarrow},
+	{"FullwidthPunctuationToNarrow", FullwidthPunctuationToNarrow, FullwidthPunctuationToNarrow},
+	{"FullwidthSpaceToNarrow", FullwidthSpaceToNarrow, FullwidthSpaceToNarrow},
+	{"NarrowToFullwidth", NarrowToFullwidth, NarrowToFullwidth},
+	{"NarrowAlnumToFullwidth", NarrowAlnumToFullwidth, NarrowAlnumToFullwidth},
+	{"NarrowSymbolsToFullwidth", NarrowSymbolsToFullwidth, NarrowSymbolsToFullwidth},
+	{"NarrowPunctuationToFullwidth", NarrowPunctuationToFullwidth, NarrowPunctuationToFullwidth},
+	{"NarrowSpaceToFullwidth", NarrowSpaceToFullwidth, NarrowSpaceToFullwidth},
+	{"ComposeHangulJamo", ComposeHangulJamo, ComposeHangulJamo},
+	{"WideKatakanaToHalfwidth", WideKatakanaToHalfwidth, WideKatakanaToHalfwidth},
 	{"KatakanaToHiragana", KatakanaToHiragana, KatakanaToHiragana},
 	{"HiraganaToKatakana", HiraganaToKatakana, HiraganaToKatakana},
 	{"CompatWideKatakanaToHalfwidth", CompatWideKatakanaToHalfwidth, CompatWideKatakanaToHalfwidth},
@@ -389,6 +1030,26 @@ var flagNames = []struct {
 	{"CompatKeepHalfwidthHangul", CompatKeepHalfwidthHangul, CompatKeepHalfwidthHangul},
 	{"CompatKeepHalfwidthSymbols", CompatKeepHalfwidthSymbols, CompatKeepHalfwidthSymbols},
 	{"CompatKanaRestriction", CompatKanaRestriction, CompatKanaRestriction},
+	{"RomajiToHiragana", RomajiToHiragana, RomajiToHiragana},
+	{"RomajiToKatakana", RomajiToKatakana, RomajiToKatakana},
+	{"SmallKanaToLarge", SmallKanaToLarge, SmallKanaToLarge},
+	{"StripVoicedSoundMarks", StripVoicedSoundMarks, StripVoicedSoundMarks},
+	{"ComposeVoicedSoundMarks", ComposeVoicedSoundMarks, ComposeVoicedSoundMarks},
+	{"ExpandIterationMarks", ExpandIterationMarks, ExpandIterationMarks},
+	{"ExpandVerticalIterationMarks", ExpandVerticalIterationMarks, ExpandVerticalIterationMarks},
+	{"ExpandKanjiIterationMarks", ExpandKanjiIterationMarks, ExpandKanjiIterationMarks},
+	{"ExpandProlongedSoundMark", ExpandProlongedSoundMark, ExpandProlongedSoundMark},
+	{"ExpandKanaCompatibility", ExpandKanaCompatibility, ExpandKanaCompatibility},
+	{"NormalizeEnclosedAlphanumerics", NormalizeEnclosedAlphanumerics, NormalizeEnclosedAlphanumerics},
+	{"NormalizeSuperscripts", NormalizeSuperscripts, NormalizeSuperscripts},
+	{"NormalizeCJKCompatibilityIdeographs", NormalizeCJKCompatibilityIdeographs, NormalizeCJKCompatibilityIdeographs},
+	{"NormalizeKangxiRadicals", NormalizeKangxiRadicals, NormalizeKangxiRadicals},
+	{"NormalizeSquaredLatinAbbreviations", NormalizeSquaredLatinAbbreviations, NormalizeSquaredLatinAbbreviations},
+	{"NormalizeVerticalForms", NormalizeVerticalForms, NormalizeVerticalForms},
+	{"NormalizeDashes", NormalizeDashes, NormalizeDashes},
+	{"NormalizeTildes", NormalizeTildes, NormalizeTildes},
+	{"NormalizeQuotes", NormalizeQuotes, NormalizeQuotes},
+	{"CornerBracketsToQuotes", CornerBracketsToQuotes, CornerBracketsToQuotes},
 }
 
 func (o ConvertOptions) String() string {
@@ -113,6 +113,46 @@ func TestConvertOptionsNormalize(t *testing.T) {
 			input:    kana.HiraganaToKatakana | kana.CompatKanaRestriction,
 			expected: kana.HiraganaToKatakana | kana.CompatKanaRestriction,
 		},
+		{
+			name:     "RomajiToKatakana, without RomajiToHiragana",
+			input:    kana.RomajiToKatakana,
+			expected: kana.RomajiToKatakana,
+		},
+		{
+			name:     "RomajiToKatakana, with RomajiToHiragana",
+			input:    kana.RomajiToHiragana | kana.RomajiToKatakana,
+			expected: kana.RomajiToHiragana,
+		},
+		{
+			name:     "CompatKeepSpaces, with FullwidthAlnumToNarrow only",
+			input:    kana.FullwidthAlnumToNarrow | kana.CompatKeepSpaces,
+			expected: kana.FullwidthAlnumToNarrow,
+		},
+		{
+			name:     "CompatBrackets, with FullwidthSymbolsToNarrow only",
+			input:    kana.FullwidthSymbolsToNarrow | kana.CompatBrackets | kana.CompatQuotes,
+			expected: kana.FullwidthSymbolsToNarrow | kana.CompatQuotes,
+		},
+		{
+			name:     "NarrowToFullwidth, with FullwidthToNarrow",
+			input:    kana.FullwidthToNarrow | kana.NarrowToFullwidth,
+			expected: kana.FullwidthToNarrow,
+		},
+		{
+			name:     "NarrowToFullwidth, with FullwidthAlnumToNarrow",
+			input:    kana.FullwidthAlnumToNarrow | kana.NarrowToFullwidth,
+			expected: kana.FullwidthAlnumToNarrow | kana.NarrowSymbolsToFullwidth | kana.NarrowPunctuationToFullwidth | kana.NarrowSpaceToFullwidth,
+		},
+		{
+			name:     "CornerBracketsToQuotes, without NormalizeQuotes",
+			input:    kana.CornerBracketsToQuotes,
+			expected: 0,
+		},
+		{
+			name:     "CornerBracketsToQuotes, with NormalizeQuotes",
+			input:    kana.NormalizeQuotes | kana.CornerBracketsToQuotes,
+			expected: kana.NormalizeQuotes | kana.CornerBracketsToQuotes,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -137,8 +177,8 @@ func TestConvertOptionsString(t *testing.T) {
 		},
 		{
 			name:     "unknown bit",
-			opts:     1 << 30,
-			expected: "0x40000000",
+			opts:     1 << 60,
+			expected: "0x1000000000000000",
 		},
 		{
 			name:     "one",
@@ -147,18 +187,28 @@ func TestConvertOptionsString(t *testing.T) {
 		},
 		{
 			name:     "one plus extra",
-			opts:     kana.HalfwidthToWide | (1 << 30),
-			expected: "HalfwidthToWide | 0x40000000",
+			opts:     kana.HalfwidthToWide | (1 << 60),
+			expected: "HalfwidthToWide | 0x1000000000000000",
 		},
 		{
 			name:     "two",
 			opts:     kana.HalfwidthToWide | kana.KatakanaToHiragana,
 			expected: "HalfwidthToWide | KatakanaToHiragana",
 		},
+		{
+			name:     "union",
+			opts:     kana.FullwidthToNarrow | kana.KatakanaToHiragana,
+			expected: "FullwidthToNarrow | KatakanaToHiragana",
+		},
+		{
+			name:     "part of union",
+			opts:     kana.FullwidthAlnumToNarrow | kana.FullwidthSpaceToNarrow,
+			expected: "FullwidthAlnumToNarrow | FullwidthSpaceToNarrow",
+		},
 		{
 			name:     "two plus extra",
-			opts:     kana.HalfwidthToWide | kana.KatakanaToHiragana | (1 << 30),
-			expected: "HalfwidthToWide | KatakanaToHiragana | 0x40000000",
+			opts:     kana.HalfwidthToWide | kana.KatakanaToHiragana | (1 << 60),
+			expected: "HalfwidthToWide | KatakanaToHiragana | 0x1000000000000000",
 		},
 	}
 	for _, tc := range testcases {
@@ -0,0 +1,9 @@
+//go:build race
+// +build race
+
+package kana_test
+
+// raceEnabled is true if the race detector is enabled,
+// which randomly drops the pipelines pooled for reuse,
+// so the allocations cannot be counted.
+const raceEnabled = true
@@ -0,0 +1,176 @@
+package kana
+
+import "unicode"
+
+// maxRomajiLen is the length of the longest key in romajiTable.
+const maxRomajiLen = 4
+
+func convertRomajiToKana(ch rune, strm *stream, buf *[]rune, opts ConvertOptions) bool {
+	if opts&(RomajiToHiragana|RomajiToKatakana) == 0 {
+		return false
+	}
+	if ch == '-' {
+		*buf = append(*buf, '\u30FC')
+		return true
+	}
+	c, ok := romajiLetter(ch)
+	if !ok {
+		return false
+	}
+
+	// Letters followed by combining marks (e.g. a followed by
+	// U+0300 COMBINING GRAVE ACCENT) are not romaji, so that
+	// the result does not depend on the normalization form.
+	ahead := strm.peek(maxRomajiLen)
+	if len(ahead) > 0 && isCombiningMark(ahead[0]) {
+		return false
+	}
+
+	// Collect the letters following ch
+	var key [maxRomajiLen]byte
+	key[0] = c
+	n := 1
+	for i := 0; i < maxRomajiLen-1 && i < len(ahead); i++ {
+		l, ok := romajiLetter(ahead[i])
+		if !ok || i+1 < len(ahead) && isCombiningMark(ahead[i+1]) {
+			break
+		}
+		key[n] = l
+		n++
+	}
+
+	// Longest match
+	for l := n; l >= 1; l-- {
+		if kana, ok := romajiTable[string(key[:l])]; ok {
+			strm.consume(l - 1)
+			appendRomajiKana(buf, kana, opts)
+			return true
+		}
+	}
+
+	if c == 'n' {
+		if n == 1 && len(ahead) > 0 && ahead[0] == '\'' {
+			strm.consume(1)
+			appendRomajiKana(buf, "ん", opts)
+			return true
+		}
+		if n == 1 || !isRomajiVowel(key[1]) && key[1] != 'y' {
+			appendRomajiKana(buf, "ん", opts)
+			return true
+		}
+	} else if n >= 2 && !isRomajiVowel(c) && (key[1] == c || c == 't' && n >= 3 && key[1] == 'c' && key[2] == 'h') {
+		// Sokuon
+		appendRomajiKana(buf, "っ", opts)
+		return true
+	}
+	return false
+}
+
+func appendRomajiKana(buf *[]rune, kana string, opts ConvertOptions) {
+	for _, ch := range kana {
+		if opts&RomajiToHiragana == 0 && ch >= '\u3041' && ch <= '\u3096' {
+			ch = ch - '\u3040' + '\u30A0'
+		}
+		*buf = append(*buf, ch)
+	}
+}
+
+// romajiLetter returns the lowercase form of ch if it is a Latin letter.
+func romajiLetter(ch rune) (byte, bool) {
+	switch {
+	case 'a' <= ch && ch <= 'z':
+		return byte(ch), true
+	case 'A' <= ch && ch <= 'Z':
+		return byte(ch - 'A' + 'a'), true
+	}
+	return 0, false
+}
+
+// isCombiningMark reports whether ch is a combining mark
+// that prevents the preceding letter from being romaji.
+//
+// The kana voiced and semi-voiced sound marks are excluded,
+// as they may be produced from other characters by the width conversion
+// or [ComposeVoicedSoundMarks].
+func isCombiningMark(ch rune) bool {
+	return ch >= '\u0300' && ch != '\u3099' && ch != '\u309A' && unicode.Is(unicode.M, ch)
+}
+
+func isRomajiVowel(c byte) bool {
+	switch c {
+	case 'a', 'i', 'u', 'e', 'o':
+		return true
+	}
+	return false
+}
+
+var romajiTable = map[string]string{
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"kya": "きゃ", "kyi": "きぃ", "kyu": "きゅ", "kye": "きぇ", "kyo": "きょ",
+	"kwa": "くぁ",
+
+	"qa": "くぁ", "qi": "くぃ", "qu": "く", "qe": "くぇ", "qo": "くぉ",
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"gya": "ぎゃ", "gyi": "ぎぃ", "gyu": "ぎゅ", "gye": "ぎぇ", "gyo": "ぎょ",
+	"gwa": "ぐぁ",
+
+	"sa": "さ", "si": "し", "shi": "し", "su": "す", "se": "せ", "so": "そ",
+	"sya": "しゃ", "syi": "しぃ", "syu": "しゅ", "sye": "しぇ", "syo": "しょ",
+	"sha": "しゃ", "shu": "しゅ", "she": "しぇ", "sho": "しょ",
+	"za": "ざ", "zi": "じ", "zu": "ず", "ze": "ぜ", "zo": "ぞ",
+	"zya": "じゃ", "zyi": "じぃ", "zyu": "じゅ", "zye": "じぇ", "zyo": "じょ",
+	"ja": "じゃ", "ji": "じ", "ju": "じゅ", "je": "じぇ", "jo": "じょ",
+	"jya": "じゃ", "jyi": "じぃ", "jyu": "じゅ", "jye": "じぇ", "jyo": "じょ",
+
+	"ta": "た", "ti": "ち", "chi": "ち", "tu": "つ", "tsu": "つ", "te": "て", "to": "と",
+	"tya": "ちゃ", "tyi": "ちぃ", "tyu": "ちゅ", "tye": "ちぇ", "tyo": "ちょ",
+	"cha": "ちゃ", "chu": "ちゅ", "che": "ちぇ", "cho": "ちょ",
+	"cya": "ちゃ", "cyi": "ちぃ", "cyu": "ちゅ", "cye": "ちぇ", "cyo": "ちょ",
+	"tsa": "つぁ", "tsi": "つぃ", "tse": "つぇ", "tso": "つぉ",
+	"tha": "てゃ", "thi": "てぃ", "thu": "てゅ", "the": "てぇ", "tho": "てょ",
+	"twu": "とぅ",
+
+	"ca": "か", "ci": "し", "cu": "く", "ce": "せ", "co": "こ",
+	"da": "だ", "di": "ぢ", "du": "づ", "de": "で", "do": "ど",
+	"dya": "ぢゃ", "dyi": "ぢぃ", "dyu": "ぢゅ", "dye": "ぢぇ", "dyo": "ぢょ",
+	"dha": "でゃ", "dhi": "でぃ", "dhu": "でゅ", "dhe": "でぇ", "dho": "でょ",
+	"dwu": "どぅ",
+
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"nya": "にゃ", "nyi": "にぃ", "nyu": "にゅ", "nye": "にぇ", "nyo": "にょ",
+	"nn": "ん", "xn": "ん",
+
+	"ha": "は", "hi": "ひ", "hu": "ふ", "fu": "ふ", "he": "へ", "ho": "ほ",
+	"hya": "ひゃ", "hyi": "ひぃ", "hyu": "ひゅ", "hye": "ひぇ", "hyo": "ひょ",
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ",
+	"fya": "ふゃ", "fyu": "ふゅ", "fyo": "ふょ",
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"bya": "びゃ", "byi": "びぃ", "byu": "びゅ", "bye": "びぇ", "byo": "びょ",
+	"va": "ゔぁ", "vi": "ゔぃ", "vu": "ゔ", "ve": "ゔぇ", "vo": "ゔぉ",
+	"vyu": "ゔゅ",
+	"pa":  "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"pya": "ぴゃ", "pyi": "ぴぃ", "pyu": "ぴゅ", "pye": "ぴぇ", "pyo": "ぴょ",
+
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"mya": "みゃ", "myi": "みぃ", "myu": "みゅ", "mye": "みぇ", "myo": "みょ",
+
+	"ya": "や", "yi": "い", "yu": "ゆ", "ye": "いぇ", "yo": "よ",
+
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"rya": "りゃ", "ryi": "りぃ", "ryu": "りゅ", "rye": "りぇ", "ryo": "りょ",
+
+	"wa": "わ", "wi": "うぃ", "wu": "う", "we": "うぇ", "wo": "を",
+	"wha": "うぁ", "whi": "うぃ", "whu": "う", "whe": "うぇ", "who": "うぉ",
+	"wyi": "ゐ", "wye": "ゑ",
+
+	"xa": "ぁ", "xi": "ぃ", "xu": "ぅ", "xe": "ぇ", "xo": "ぉ",
+	"la": "ぁ", "li": "ぃ", "lu": "ぅ", "le": "ぇ", "lo": "ぉ",
+	"xyi": "ぃ", "xye": "ぇ", "lyi": "ぃ", "lye": "ぇ",
+	"xya": "ゃ", "xyu": "ゅ", "xyo": "ょ",
+	"lya": "ゃ", "lyu": "ゅ", "lyo": "ょ",
+	"xtu": "っ", "xtsu": "っ", "ltu": "っ", "ltsu": "っ",
+	"xwa": "ゎ", "lwa": "ゎ",
+	"xka": "ゕ", "lka": "ゕ", "xke": "ゖ", "lke": "ゖ",
+}
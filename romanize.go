@@ -0,0 +1,356 @@
+package kana
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// RomanizationSystem describes a romanization system for [ToRomaji].
+//
+// It consists of a base system (one of [ModifiedHepburn], [Kunrei],
+// [NihonShiki] and [PassportHepburn]) optionally combined with
+// a long vowel style (one of [LongVowelMacron], [LongVowelCircumflex],
+// [LongVowelDoubled] and [LongVowelDropped]) using the | operator.
+type RomanizationSystem int
+
+const (
+	// ModifiedHepburn is the modified Hepburn romanization.
+	//
+	//  - し, ち, つ and ふ are romanized as shi, chi, tsu and fu.
+	//  - じ and ぢ are romanized as ji, ず and づ as zu.
+	//  - を is romanized as o.
+	//  - ん is romanized as n, and n' before a vowel or y.
+	//  - っ doubles the next consonant, except that っち is romanized as tchi.
+	//  - Long vowels are written with a macron by default.
+	ModifiedHepburn RomanizationSystem = iota
+	// Kunrei is the Kunrei-shiki romanization (ISO 3602).
+	//
+	//  - し, ち, つ and ふ are romanized as si, ti, tu and hu.
+	//  - じ and ぢ are romanized as zi, ず and づ as zu.
+	//  - を is romanized as o.
+	//  - ん is romanized as n, and n' before a vowel or y.
+	//  - っ doubles the next consonant.
+	//  - Long vowels are written with a circumflex by default.
+	Kunrei
+	// NihonShiki is the Nihon-shiki romanization (ISO 3602 Strict).
+	//
+	// It is the same as [Kunrei] except that ぢ, づ, を, ゐ and ゑ
+	// are romanized as di, du, wo, wi and we, and くゎ and ぐゎ as kwa and gwa.
+	NihonShiki
+	// PassportHepburn is the Hepburn romanization used for
+	// Japanese passports.
+	//
+	// It is the same as [ModifiedHepburn] except that:
+	//
+	//  - ん is romanized as m before b, m and p, and no apostrophe is used.
+	//  - Long vowels are dropped by default.
+	PassportHepburn
+)
+
+const (
+	// LongVowelMacron writes long vowels with a macron (ā, ī, ū, ē, ō).
+	LongVowelMacron RomanizationSystem = (iota + 1) << 4
+	// LongVowelCircumflex writes long vowels with a circumflex (â, î, û, ê, ô).
+	LongVowelCircumflex
+	// LongVowelDoubled writes long vowels by doubling the vowel (aa, ii, uu, ee, oo).
+	LongVowelDoubled
+	// LongVowelDropped writes long vowels as short ones (a, i, u, e, o).
+	LongVowelDropped
+)
+
+const (
+	romanizationBaseMask      RomanizationSystem = 0xF
+	romanizationLongVowelMask RomanizationSystem = 0x7 << 4
+)
+
+func (s RomanizationSystem) base() RomanizationSystem {
+	return s & romanizationBaseMask
+}
+
+func (s RomanizationSystem) longVowel() RomanizationSystem {
+	if lv := s & romanizationLongVowelMask; lv != 0 {
+		return lv
+	}
+	switch s.base() {
+	case Kunrei, NihonShiki:
+		return LongVowelCircumflex
+	case PassportHepburn:
+		return LongVowelDropped
+	}
+	return LongVowelMacron
+}
+
+func (s RomanizationSystem) isHepburn() bool {
+	return s.base() != Kunrei && s.base() != NihonShiki
+}
+
+// ToRomaji transliterates hiragana and katakana in s to romaji
+// with the given romanization system.
+//
+// Halfwidth katakana are accepted as well, since the input is converted
+// with [HalfwidthToWide] first. Other characters are left as is,
+// except for the other halfwidth forms converted by [HalfwidthToWide].
+// Decomposed kana (e.g. か followed by U+3099) are composed
+// with [ComposeVoicedSoundMarks] as well.
+//
+// The following sequences are treated as long vowels:
+//
+//   - aa, uu, ee, oo and ou (e.g. おかあさん, とうきょう)
+//   - a vowel followed by U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー)
+//
+// Note that morpheme boundaries are not taken into account,
+// so that おもう is romanized as omō in [ModifiedHepburn].
+//
+// U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー) not following a vowel
+// is romanized as '-'. U+3063 HIRAGANA LETTER SMALL TU (っ) not followed
+// by a consonant is dropped.
+func ToRomaji(s string, system RomanizationSystem) string {
+	hira := Convert(s, HalfwidthToWide|KatakanaToHiragana|ComposeVoicedSoundMarks)
+
+	tokens := tokenizeKana(hira, system)
+	builder := strings.Builder{}
+	builder.Grow(len(s))
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		switch tok.kind {
+		case romanizeSokuon:
+			if i+1 < len(tokens) && tokens[i+1].kind == romanizeSyllable && !isLatinVowel(tokens[i+1].text[0]) {
+				next := tokens[i+1].text
+				if system.isHepburn() && strings.HasPrefix(next, "ch") {
+					builder.WriteByte('t')
+				} else {
+					builder.WriteByte(next[0])
+				}
+			}
+		case romanizeHatsuon:
+			var next byte
+			if i+1 < len(tokens) && tokens[i+1].kind == romanizeSyllable {
+				next = tokens[i+1].text[0]
+			}
+			if system.base() == PassportHepburn {
+				if next == 'b' || next == 'm' || next == 'p' {
+					builder.WriteByte('m')
+				} else {
+					builder.WriteByte('n')
+				}
+			} else if isLatinVowel(next) || next == 'y' {
+				builder.WriteString("n'")
+			} else {
+				builder.WriteByte('n')
+			}
+		case romanizeProlong:
+			builder.WriteByte('-')
+		case romanizeSyllable:
+			text := tok.text
+			last := text[len(text)-1]
+			long := false
+			for isLatinVowel(last) && i+1 < len(tokens) && extendsVowel(last, tokens[i+1]) {
+				long = true
+				i++
+			}
+			if long {
+				builder.WriteString(text[:len(text)-1])
+				writeLongVowel(&builder, last, system.longVowel())
+			} else {
+				builder.WriteString(text)
+			}
+		default:
+			builder.WriteString(tok.text)
+		}
+	}
+	return builder.String()
+}
+
+type romanizeTokenKind int
+
+const (
+	romanizeOther romanizeTokenKind = iota
+	romanizeSyllable
+	romanizeSokuon
+	romanizeHatsuon
+	romanizeProlong
+)
+
+type romanizeToken struct {
+	kind romanizeTokenKind
+	text string
+	// vowel is true if the token is a standalone large vowel (あいうえお).
+	vowel bool
+}
+
+func tokenizeKana(hira string, system RomanizationSystem) []romanizeToken {
+	var tokens []romanizeToken
+	for i := 0; i < len(hira); {
+		ch, size := utf8.DecodeRuneInString(hira[i:])
+		switch ch {
+		case '\u3063':
+			tokens = append(tokens, romanizeToken{kind: romanizeSokuon})
+			i += size
+			continue
+		case '\u3093':
+			tokens = append(tokens, romanizeToken{kind: romanizeHatsuon})
+			i += size
+			continue
+		case '\u30FC':
+			tokens = append(tokens, romanizeToken{kind: romanizeProlong})
+			i += size
+			continue
+		}
+
+		// Longest match of up to two characters
+		if i+size < len(hira) {
+			_, size2 := utf8.DecodeRuneInString(hira[i+size:])
+			if text, ok := lookupRomanization(hira[i:i+size+size2], system); ok {
+				tokens = append(tokens, romanizeToken{kind: romanizeSyllable, text: text})
+				i += size + size2
+				continue
+			}
+		}
+		if text, ok := lookupRomanization(hira[i:i+size], system); ok {
+			vowel := ch == '\u3042' || ch == '\u3044' || ch == '\u3046' || ch == '\u3048' || ch == '\u304A'
+			tokens = append(tokens, romanizeToken{kind: romanizeSyllable, text: text, vowel: vowel})
+		} else {
+			tokens = append(tokens, romanizeToken{kind: romanizeOther, text: hira[i : i+size]})
+		}
+		i += size
+	}
+	return tokens
+}
+
+func lookupRomanization(kana string, system RomanizationSystem) (string, bool) {
+	switch system.base() {
+	case NihonShiki:
+		if text, ok := nihonShikiTable[kana]; ok {
+			return text, true
+		}
+		fallthrough
+	case Kunrei:
+		if text, ok := kunreiTable[kana]; ok {
+			return text, true
+		}
+	}
+	text, ok := hepburnTable[kana]
+	return text, ok
+}
+
+// extendsVowel reports whether tok makes the preceding vowel v long.
+func extendsVowel(v byte, tok romanizeToken) bool {
+	if tok.kind == romanizeProlong {
+		return true
+	}
+	if !tok.vowel {
+		return false
+	}
+	switch v {
+	case 'a', 'u', 'e':
+		return tok.text[0] == v
+	case 'o':
+		return tok.text[0] == 'o' || tok.text[0] == 'u'
+	}
+	return false
+}
+
+func writeLongVowel(builder *strings.Builder, v byte, style RomanizationSystem) {
+	switch style {
+	case LongVowelMacron:
+		builder.WriteString(macronVowels[v])
+	case LongVowelCircumflex:
+		builder.WriteString(circumflexVowels[v])
+	case LongVowelDoubled:
+		builder.WriteByte(v)
+		builder.WriteByte(v)
+	default:
+		builder.WriteByte(v)
+	}
+}
+
+func isLatinVowel(c byte) bool {
+	switch c {
+	case 'a', 'i', 'u', 'e', 'o':
+		return true
+	}
+	return false
+}
+
+var macronVowels = map[byte]string{
+	'a': "ā", 'i': "ī", 'u': "ū", 'e': "ē", 'o': "ō",
+}
+
+var circumflexVowels = map[byte]string{
+	'a': "â", 'i': "î", 'u': "û", 'e': "ê", 'o': "ô",
+}
+
+var hepburnTable = map[string]string{
+	"あ": "a", "い": "i", "う": "u", "え": "e", "お": "o",
+	"ぁ": "a", "ぃ": "i", "ぅ": "u", "ぇ": "e", "ぉ": "o",
+
+	"か": "ka", "き": "ki", "く": "ku", "け": "ke", "こ": "ko",
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo",
+	"くぁ": "kwa", "くぃ": "kwi", "くぇ": "kwe", "くぉ": "kwo", "くゎ": "kuwa",
+	"ゕ": "ka", "ゖ": "ke",
+
+	"が": "ga", "ぎ": "gi", "ぐ": "gu", "げ": "ge", "ご": "go",
+	"ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"ぐぁ": "gwa", "ぐゎ": "guwa",
+
+	"さ": "sa", "し": "shi", "す": "su", "せ": "se", "そ": "so",
+	"しゃ": "sha", "しゅ": "shu", "しぇ": "she", "しょ": "sho",
+	"すぃ": "si",
+
+	"ざ": "za", "じ": "ji", "ず": "zu", "ぜ": "ze", "ぞ": "zo",
+	"じゃ": "ja", "じゅ": "ju", "じぇ": "je", "じょ": "jo",
+	"ずぃ": "zi",
+
+	"た": "ta", "ち": "chi", "つ": "tsu", "て": "te", "と": "to",
+	"ちゃ": "cha", "ちゅ": "chu", "ちぇ": "che", "ちょ": "cho",
+	"つぁ": "tsa", "つぃ": "tsi", "つぇ": "tse", "つぉ": "tso",
+	"てぃ": "ti", "てゅ": "tyu", "とぅ": "tu",
+
+	"だ": "da", "ぢ": "ji", "づ": "zu", "で": "de", "ど": "do",
+	"ぢゃ": "ja", "ぢゅ": "ju", "ぢょ": "jo",
+	"でぃ": "di", "でゅ": "dyu", "どぅ": "du",
+
+	"な": "na", "に": "ni", "ぬ": "nu", "ね": "ne", "の": "no",
+	"にゃ": "nya", "にゅ": "nyu", "にょ": "nyo",
+
+	"は": "ha", "ひ": "hi", "ふ": "fu", "へ": "he", "ほ": "ho",
+	"ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo",
+	"ふぁ": "fa", "ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo", "ふゅ": "fyu",
+
+	"ば": "ba", "び": "bi", "ぶ": "bu", "べ": "be", "ぼ": "bo",
+	"びゃ": "bya", "びゅ": "byu", "びょ": "byo",
+
+	"ぱ": "pa", "ぴ": "pi", "ぷ": "pu", "ぺ": "pe", "ぽ": "po",
+	"ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+
+	"ゔ": "vu", "ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo", "ゔゅ": "vyu",
+	"わ゙": "va", "ゐ゙": "vi", "ゑ゙": "ve", "を゙": "vo",
+
+	"ま": "ma", "み": "mi", "む": "mu", "め": "me", "も": "mo",
+	"みゃ": "mya", "みゅ": "myu", "みょ": "myo",
+
+	"や": "ya", "ゆ": "yu", "よ": "yo", "いぇ": "ye",
+	"ゃ": "ya", "ゅ": "yu", "ょ": "yo",
+
+	"ら": "ra", "り": "ri", "る": "ru", "れ": "re", "ろ": "ro",
+	"りゃ": "rya", "りゅ": "ryu", "りょ": "ryo",
+
+	"わ": "wa", "ゐ": "i", "ゑ": "e", "を": "o", "ゎ": "wa",
+	"うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+}
+
+// kunreiTable overrides hepburnTable for Kunrei-shiki.
+var kunreiTable = map[string]string{
+	"し": "si", "しゃ": "sya", "しゅ": "syu", "しぇ": "sye", "しょ": "syo",
+	"じ": "zi", "じゃ": "zya", "じゅ": "zyu", "じぇ": "zye", "じょ": "zyo",
+	"ち": "ti", "ちゃ": "tya", "ちゅ": "tyu", "ちぇ": "tye", "ちょ": "tyo",
+	"つ": "tu", "ふ": "hu",
+	"ぢ": "zi", "ぢゃ": "zya", "ぢゅ": "zyu", "ぢょ": "zyo",
+}
+
+// nihonShikiTable overrides kunreiTable for Nihon-shiki.
+var nihonShikiTable = map[string]string{
+	"ぢ": "di", "ぢゃ": "dya", "ぢゅ": "dyu", "ぢょ": "dyo", "づ": "du",
+	"を": "wo", "ゐ": "wi", "ゑ": "we",
+	"くゎ": "kwa", "ぐゎ": "gwa",
+}
@@ -0,0 +1,182 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestToRomaji(t *testing.T) {
+	var testcases = []struct {
+		name   string
+		input  string
+		system kana.RomanizationSystem
+		expect string
+	}{
+		{
+			name:   "Basic syllables in Hepburn",
+			input:  "さしすせそたちつてとはひふへほ",
+			system: kana.ModifiedHepburn,
+			expect: "sashisusesotachitsutetohahifuheho",
+		},
+		{
+			name:   "Basic syllables in Kunrei",
+			input:  "さしすせそたちつてとはひふへほ",
+			system: kana.Kunrei,
+			expect: "sasisusesotatitutetohahihuheho",
+		},
+		{
+			name:   "Voiced syllables in Hepburn",
+			input:  "じずぢづを",
+			system: kana.ModifiedHepburn,
+			expect: "jizujizuo",
+		},
+		{
+			name:   "Voiced syllables in Kunrei",
+			input:  "じずぢづを",
+			system: kana.Kunrei,
+			expect: "zizuzizuo",
+		},
+		{
+			name:   "Voiced syllables in Nihon-shiki",
+			input:  "じずぢづを",
+			system: kana.NihonShiki,
+			expect: "zizudiduwo",
+		},
+		{
+			name:   "Yoon in Hepburn",
+			input:  "きゃしゅちょじゃにゅひょりゃ",
+			system: kana.ModifiedHepburn,
+			expect: "kyashuchojanyuhyorya",
+		},
+		{
+			name:   "Yoon in Kunrei",
+			input:  "きゃしゅちょじゃにゅひょりゃ",
+			system: kana.Kunrei,
+			expect: "kyasyutyozyanyuhyorya",
+		},
+		{
+			name:   "Katakana",
+			input:  "カタカナ",
+			system: kana.ModifiedHepburn,
+			expect: "katakana",
+		},
+		{
+			name:   "Halfwidth katakana",
+			input:  "ｶﾞｯｺｳ",
+			system: kana.ModifiedHepburn,
+			expect: "gakkō",
+		},
+		{
+			name:   "Decomposed kana",
+			input:  "か\u3099っこう ハ\u309Aン",
+			system: kana.ModifiedHepburn,
+			expect: "gakkō pan",
+		},
+		{
+			name:   "Extended katakana",
+			input:  "ヴァイオリン ティー ファン ディズニー ウェブ",
+			system: kana.ModifiedHepburn,
+			expect: "vaiorin tī fan dizunī webu",
+		},
+		{
+			name:   "Katakana VA",
+			input:  "ヷヸヹヺ",
+			system: kana.ModifiedHepburn,
+			expect: "vavivevo",
+		},
+		{
+			name:   "Sokuon in Hepburn",
+			input:  "がっこう まっちゃ",
+			system: kana.ModifiedHepburn,
+			expect: "gakkō matcha",
+		},
+		{
+			name:   "Sokuon in Kunrei",
+			input:  "がっこう まっちゃ",
+			system: kana.Kunrei,
+			expect: "gakkô mattya",
+		},
+		{
+			name:   "Trailing sokuon",
+			input:  "あっ",
+			system: kana.ModifiedHepburn,
+			expect: "a",
+		},
+		{
+			name:   "Hatsuon in Hepburn",
+			input:  "しんぶん しんいち きんようび",
+			system: kana.ModifiedHepburn,
+			expect: "shinbun shin'ichi kin'yōbi",
+		},
+		{
+			name:   "Hatsuon in passport Hepburn",
+			input:  "しんぶん しんいち なんば しんぺい",
+			system: kana.PassportHepburn,
+			expect: "shimbun shinichi namba shimpei",
+		},
+		{
+			name:   "Long vowels in Hepburn",
+			input:  "とうきょう おおさか おかあさん にいがた おねえさん",
+			system: kana.ModifiedHepburn,
+			expect: "tōkyō ōsaka okāsan niigata onēsan",
+		},
+		{
+			name:   "Long vowels in Kunrei",
+			input:  "とうきょう おおさか",
+			system: kana.Kunrei,
+			expect: "tôkyô ôsaka",
+		},
+		{
+			name:   "Long vowels in passport Hepburn",
+			input:  "さとう ゆうこ",
+			system: kana.PassportHepburn,
+			expect: "sato yuko",
+		},
+		{
+			name:   "Long vowels doubled",
+			input:  "とうきょう コーヒー",
+			system: kana.ModifiedHepburn | kana.LongVowelDoubled,
+			expect: "tookyoo koohii",
+		},
+		{
+			name:   "Long vowels with circumflex in Hepburn",
+			input:  "とうきょう",
+			system: kana.ModifiedHepburn | kana.LongVowelCircumflex,
+			expect: "tôkyô",
+		},
+		{
+			name:   "Long vowels with macron in Kunrei",
+			input:  "とうきょう",
+			system: kana.Kunrei | kana.LongVowelMacron,
+			expect: "tōkyō",
+		},
+		{
+			name:   "Prolonged sound mark",
+			input:  "ラーメン コンピューター",
+			system: kana.ModifiedHepburn,
+			expect: "rāmen konpyūtā",
+		},
+		{
+			name:   "Prolonged sound mark without vowel",
+			input:  "ー",
+			system: kana.ModifiedHepburn,
+			expect: "-",
+		},
+		{
+			name:   "Non-kana characters",
+			input:  "東京タワー 2024",
+			system: kana.ModifiedHepburn,
+			expect: "東京tawā 2024",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := kana.ToRomaji(tc.input, tc.system)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
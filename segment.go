@@ -0,0 +1,326 @@
+package kana
+
+import "unicode/utf8"
+
+// maxSegmentLen is the maximum number of characters in a segment
+// when more input may follow.
+const maxSegmentLen = 64
+
+// maxRomajiSegmentLen is the maximum number of characters in a segment
+// ending with a run of romaji consonants when more input may follow.
+// A consonant may be converted to a sokuon depending on the next one,
+// so a run is kept in a segment as long as possible, while the segment
+// and its result still fit in the 4096-byte buffers of
+// transform.NewReader and transform.NewWriter.
+const maxRomajiSegmentLen = 1024
+
+// nextSegment returns the length of the segment starting at p.
+//
+// A segment is a sequence of characters that must be converted together,
+// such as a halfwidth katakana followed by a halfwidth voiced sound mark.
+// Converting a string segment by segment gives the same result
+// as converting it at once.
+//
+// The second return value is false if more input is needed to determine
+// the end of the segment.
+//
+// To bound the lookahead needed for streaming, a segment is cut
+// after maxSegmentLen characters, or maxRomajiSegmentLen characters
+// in a run of romaji consonants, if atEOF is false,
+// even if the last character joins with the next one,
+// in which case the result may differ from that of converting at once.
+func nextSegment(in input, p int, atEOF bool, opts ConvertOptions) (int, bool) {
+	if !atEOF && !in.fullRune(p) {
+		return 0, false
+	}
+	prev, n := in.decodeRune(p)
+	for i := 1; (atEOF || i < maxSegmentLen || i < maxRomajiSegmentLen && isRomajiConsonantInput(prev, opts)) && mayJoinNext(prev, opts); i++ {
+		if p+n == in.len() {
+			return n, atEOF
+		}
+		if !atEOF && !in.fullRune(p+n) {
+			return 0, false
+		}
+		ch, size := in.decodeRune(p + n)
+		if !joins(prev, ch, opts) {
+			break
+		}
+		prev = ch
+		n += size
+	}
+	return n, true
+}
+
+// untouchedSpan returns the length of the longest run of characters
+// starting at p that are not affected by the conversion.
+func untouchedSpan(in input, p int, opts ConvertOptions) int {
+	q := p
+	for q < in.len() {
+		if c := in.at(q); c < utf8.RuneSelf {
+			if mayChange(rune(c), opts) {
+				break
+			}
+			q++
+			continue
+		}
+		ch, size := in.decodeRune(q)
+		if ch == utf8.RuneError && size == 1 || mayChange(ch, opts) {
+			// Invalid UTF-8 sequences are replaced with U+FFFD.
+			break
+		}
+		q += size
+	}
+	return q - p
+}
+
+// touchedSpan returns the length of the longest run of characters
+// starting at p that may be affected by the conversion.
+func touchedSpan(in input, p int, opts ConvertOptions) int {
+	q := p
+	for q < in.len() {
+		ch, size := in.decodeRune(q)
+		if !(ch == utf8.RuneError && size == 1 || mayChange(ch, opts)) {
+			break
+		}
+		q += size
+	}
+	return q - p
+}
+
+// mayChange reports whether ch may be changed by the conversion,
+// or may affect the conversion of the characters around it.
+//
+// It is conservative: it may return true for characters
+// that are actually kept as is.
+func mayChange(ch rune, opts ConvertOptions) bool {
+	if opts&(RomajiToHiragana|RomajiToKatakana) != 0 && (mayBeRomajiInput(ch, opts) || isCombiningMark(ch)) {
+		return true
+	}
+	if opts&NormalizeDashes != 0 && (isDash(ch) || isProlongedSoundMark(ch) || isDigitAroundDash(ch)) {
+		// Including the digits around the prolonged sound marks
+		return true
+	}
+	if opts&NarrowToFullwidth != 0 && (' ' <= ch && ch <= '~' || narrowMap[ch] != 0) {
+		return true
+	}
+	if ch < utf8.RuneSelf {
+		return false
+	}
+	if opts&NormalizeTildes != 0 && isTilde(ch) {
+		return true
+	}
+	if normalizeQuote(ch, opts) != ch {
+		return true
+	}
+	if opts&(FullwidthToNarrow|CompatMinus|CompatOverline|CompatCurrency|CompatOtherSymbols) != 0 {
+		if '\uFF01' <= ch && ch <= '\uFF60' || '\uFFE0' <= ch && ch <= '\uFFE6' {
+			return true
+		}
+	}
+	if opts&(FullwidthToNarrow|CompatMinus|CompatOtherSymbols) != 0 && '\u00B4' <= ch && ch <= '\u2225' {
+		// Dashes, quotes, and symbols handled by compat options
+		return true
+	}
+	if opts&FullwidthToNarrow != 0 && (ch == '\u3000' || ch == '\u3008' || ch == '\u3009') {
+		return true
+	}
+	if opts&HalfwidthToWide != 0 && '\uFF61' <= ch && ch <= '\uFFEF' {
+		return true
+	}
+	if opts&CompatWideKatakanaToHalfwidth != 0 {
+		if '\u3001' <= ch && ch <= '\u300D' || '\u3099' <= ch && ch <= '\u30FC' {
+			return true
+		}
+	}
+	if opts&WideKatakanaToHalfwidth != 0 {
+		if '\u3099' <= ch && ch <= '\u309C' || '\u30A1' <= ch && ch <= '\u30FC' || '\uFF66' <= ch && ch <= '\uFF9F' {
+			return true
+		}
+	}
+	if opts&KatakanaToHiragana != 0 {
+		if '\u30A1' <= ch && ch <= '\u30FE' || '\U0001B155' <= ch && ch <= '\U0001B166' {
+			return true
+		}
+	}
+	if opts&HiraganaToKatakana != 0 {
+		if '\u3041' <= ch && ch <= '\u309E' || '\U0001B132' <= ch && ch <= '\U0001B152' {
+			return true
+		}
+	}
+	if opts&SmallKanaToLarge != 0 {
+		if '\u3041' <= ch && ch <= '\u3096' || '\u30A1' <= ch && ch <= '\u30F6' || '\u31F0' <= ch && ch <= '\u31FF' ||
+			'\uFF67' <= ch && ch <= '\uFF6F' || '\U0001B132' <= ch && ch <= '\U0001B167' {
+			return true
+		}
+	}
+	if opts&StripVoicedSoundMarks != 0 {
+		if '\u304C' <= ch && ch <= '\u309E' || '\u30AC' <= ch && ch <= '\u30FE' || '\uFF9E' <= ch && ch <= '\uFF9F' {
+			return true
+		}
+	}
+	if normalizesCompatibility(ch, opts) {
+		return true
+	}
+	if opts&ComposeVoicedSoundMarks != 0 && '\u3046' <= ch && ch <= '\u30FD' {
+		// Kana may be composed with the following sound marks
+		return true
+	}
+	if opts&ComposeHangulJamo != 0 && '\u3131' <= ch && ch <= '\u3163' {
+		// The halfwidth jamo are covered by HalfwidthToWide
+		return true
+	}
+	if mayBeIterated(ch, opts) {
+		// Including the iteration marks themselves
+		return true
+	}
+	if opts&ExpandKanjiIterationMarks != 0 && (ch == '\u3005' || ch == '\u303B') {
+		return true
+	}
+	return false
+}
+
+// mayJoinNext reports whether the conversion of ch may depend on
+// the character following it.
+func mayJoinNext(ch rune, opts ConvertOptions) bool {
+	if opts&(RomajiToHiragana|RomajiToKatakana) != 0 {
+		// A consonant may form a syllable with the following letters,
+		// and any letter may be followed by a combining mark
+		if _, ok := romajiLetter(narrowForSegment(ch)); ok {
+			return true
+		}
+		if normalizesCompatibility(ch, opts) {
+			// The expansion may contain letters
+			return true
+		}
+	}
+	if opts&HalfwidthToWide != 0 {
+		if _, ok := halfwidthVoicedKatakanaTable[ch]; ok {
+			return true
+		}
+		if _, ok := halfwidthSemiVoicedKatakanaTable[ch]; ok {
+			return true
+		}
+	}
+	if opts&NormalizeDashes != 0 && (isProlongedSoundMark(ch) || isDigitAroundDash(ch)) {
+		return true
+	}
+	if opts&WideKatakanaToHalfwidth != 0 && mayChange(ch, opts) {
+		// Anything that may result in katakana
+		// may be followed by a combining sound mark
+		return true
+	}
+	if mayComposeNext(ch, opts) {
+		return true
+	}
+	if opts&ComposeHangulJamo != 0 && mayBeHangulJamo(ch, opts) {
+		return true
+	}
+	if mayBeIterated(ch, opts) {
+		return true
+	}
+	return false
+}
+
+// joins reports whether ch must be converted together with prev.
+func joins(prev, ch rune, opts ConvertOptions) bool {
+	if opts&(RomajiToHiragana|RomajiToKatakana) != 0 {
+		if c, ok := romajiLetter(narrowForSegment(prev)); ok {
+			if isCombiningMark(ch) || !isRomajiVowel(c) && mayBeRomajiInput(ch, opts) {
+				return true
+			}
+		} else if normalizesCompatibility(prev, opts) {
+			// The expansion may end with a consonant
+			if isCombiningMark(ch) || mayBeRomajiInput(ch, opts) {
+				return true
+			}
+		}
+	}
+	if opts&HalfwidthToWide != 0 {
+		switch ch {
+		case '\uFF9E':
+			if _, ok := halfwidthVoicedKatakanaTable[prev]; ok {
+				return true
+			}
+		case '\uFF9F':
+			if _, ok := halfwidthSemiVoicedKatakanaTable[prev]; ok {
+				return true
+			}
+		}
+	}
+	if opts&NormalizeDashes != 0 {
+		// The prolonged sound marks between digits are dashes
+		if isDigitAroundDash(prev) && isProlongedSoundMark(ch) || isProlongedSoundMark(prev) && isDigitAroundDash(ch) {
+			return true
+		}
+	}
+	if opts&WideKatakanaToHalfwidth != 0 && isVoicedSoundMark(ch) && mayChange(prev, opts) {
+		// Any sound mark may be a combining one
+		// after the width conversion or the composition
+		return true
+	}
+	if opts&ComposeHangulJamo != 0 && mayBeHangulJamo(prev, opts) && mayBeHangulJamo(ch, opts) {
+		return true
+	}
+	if iterates(prev, ch, opts) {
+		return true
+	}
+	if mayComposeNext(prev, opts) {
+		if '\u3099' <= ch && ch <= '\u309C' || opts&HalfwidthToWide != 0 && (ch == '\uFF9E' || ch == '\uFF9F') {
+			return true
+		}
+	}
+	return false
+}
+
+// mayComposeNext reports whether ch may be composed with
+// the following sound mark by [ComposeVoicedSoundMarks]
+// after the width conversion.
+func mayComposeNext(ch rune, opts ConvertOptions) bool {
+	if opts&ComposeVoicedSoundMarks == 0 {
+		return false
+	}
+	if _, ok := expandKanaCompatibility(ch, opts); ok {
+		// The last kana of the expansion may be composed
+		return true
+	}
+	return '\u3046' <= ch && ch <= '\u30FD' || opts&HalfwidthToWide != 0 && '\uFF66' <= ch && ch <= '\uFF9D'
+}
+
+// isRomajiInput reports whether ch may be a part of romaji
+// after the width conversion.
+func isRomajiInput(ch rune) bool {
+	switch ch = narrowForSegment(ch); ch {
+	case '-', '\'', '\u00B4', '\u2019':
+		return true
+	}
+	_, ok := romajiLetter(ch)
+	return ok
+}
+
+// isRomajiConsonantInput reports whether ch is a romaji consonant
+// after the width conversion.
+func isRomajiConsonantInput(ch rune, opts ConvertOptions) bool {
+	if opts&(RomajiToHiragana|RomajiToKatakana) == 0 {
+		return false
+	}
+	c, ok := romajiLetter(narrowForSegment(ch))
+	return ok && !isRomajiVowel(c)
+}
+
+// mayBeRomajiInput reports whether ch may be a part of romaji
+// after the normalization and the width conversion.
+func mayBeRomajiInput(ch rune, opts ConvertOptions) bool {
+	if isRomajiInput(ch) || normalizesCompatibility(ch, opts) {
+		return true
+	}
+	return opts&NormalizeDashes != 0 && isDash(ch) || normalizeQuote(ch, opts) == '\''
+}
+
+// narrowForSegment converts a fullwidth ASCII variant to ASCII
+// regardless of the options, for conservative segmentation.
+func narrowForSegment(ch rune) rune {
+	if '\uFF01' <= ch && ch <= '\uFF5E' {
+		return ch - '\uFF00' + ' '
+	}
+	return ch
+}
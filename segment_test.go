@@ -0,0 +1,226 @@
+package kana
+
+import (
+	"strings"
+	"testing"
+)
+
+// segmentTestRanges are the ranges of characters checked against
+// the conversion pipeline.
+var segmentTestRanges = [][2]rune{
+	{0x0000, 0x33FF},
+	{0xF900, 0xFFFF},
+	{0x1B000, 0x1B16F},
+	{0x1F100, 0x1F2FF},
+}
+
+var segmentTestOptions = []ConvertOptions{
+	HalfwidthToWide,
+	FullwidthToNarrow,
+	KatakanaToHiragana,
+	HiraganaToKatakana,
+	CompatWideKatakanaToHalfwidth,
+	FullwidthToNarrow | CompatQuotes | CompatMinus | CompatOverline | CompatCurrency | CompatBrackets | CompatOtherSymbols | CompatDoubleSpaces,
+	CompatMinus | CompatOverline | CompatCurrency | CompatOtherSymbols,
+	HalfwidthToWide | CompatVoicedSoundMarks | CompatVoicedKanaRestriction,
+	KatakanaToHiragana | HiraganaToKatakana | CompatKanaRestriction,
+	RomajiToHiragana,
+	RomajiToKatakana | FullwidthToNarrow | CompatQuotes,
+	SmallKanaToLarge,
+	SmallKanaToLarge | HalfwidthToWide | KatakanaToHiragana,
+	StripVoicedSoundMarks,
+	StripVoicedSoundMarks | HalfwidthToWide | CompatVoicedSoundMarks | HiraganaToKatakana,
+	ComposeVoicedSoundMarks,
+	ComposeVoicedSoundMarks | HalfwidthToWide | CompatVoicedSoundMarks | CompatVoicedKanaRestriction | KatakanaToHiragana,
+	ComposeVoicedSoundMarks | RomajiToKatakana,
+	ExpandIterationMarks,
+	ExpandIterationMarks | HalfwidthToWide | ComposeVoicedSoundMarks | KatakanaToHiragana,
+	ExpandVerticalIterationMarks | ExpandKanjiIterationMarks,
+	ExpandIterationMarks | ExpandVerticalIterationMarks | RomajiToHiragana,
+	ExpandProlongedSoundMark | HalfwidthToWide | HiraganaToKatakana,
+	ExpandKanaCompatibility | ExpandIterationMarks | ComposeVoicedSoundMarks | KatakanaToHiragana,
+	SearchNormalize,
+	SearchNormalize | RomajiToHiragana | ExpandKanjiIterationMarks | CompatWideKatakanaToHalfwidth,
+	NormalizeDashes | NormalizeTildes,
+	NormalizeDashes | NormalizeTildes | FullwidthToNarrow | CompatMinus | CompatOverline | ExpandProlongedSoundMark,
+	NormalizeDashes | RomajiToKatakana | HalfwidthToWide,
+	NormalizeQuotes | CornerBracketsToQuotes | RomajiToHiragana | CompatWideKatakanaToHalfwidth,
+	FullwidthAlnumToNarrow | FullwidthSpaceToNarrow | CompatDoubleSpaces,
+	FullwidthSymbolsToNarrow | CompatMinus | CompatQuotes | NormalizeTildes,
+	FullwidthPunctuationToNarrow | CompatMinus | CompatBrackets | RomajiToKatakana,
+	NarrowToFullwidth | RomajiToHiragana | NormalizeDashes,
+	NarrowAlnumToFullwidth | FullwidthSymbolsToNarrow,
+	ComposeHangulJamo,
+	ComposeHangulJamo | HalfwidthToWide | ComposeVoicedSoundMarks,
+	WideKatakanaToHalfwidth,
+	ExpandProlongedSoundMark | RomajiToHiragana,
+	ExpandProlongedSoundMark | NormalizeDashes,
+	ExpandProlongedSoundMark | FullwidthPunctuationToNarrow | HalfwidthToWide | RomajiToKatakana,
+	WideKatakanaToHalfwidth | HalfwidthToWide | KatakanaToHiragana,
+	WideKatakanaToHalfwidth | ComposeVoicedSoundMarks | HalfwidthToWide,
+	WideKatakanaToHalfwidth | HiraganaToKatakana | RomajiToKatakana | ExpandIterationMarks | ExpandKanaCompatibility,
+}
+
+// segmentBoundaryTestRanges are the ranges of characters
+// checked for segment boundaries, which is more expensive.
+var segmentBoundaryTestRanges = [][2]rune{
+	{0x0000, 0x00FF},
+	{0x2000, 0x206F},
+	{0x2200, 0x22FF},
+	{0x2460, 0x24FF},
+	{0x3000, 0x33FF},
+	{0xFE10, 0xFE4F},
+	{0xFF00, 0xFFEF},
+	{0x1B000, 0x1B16F},
+}
+
+// segmentSampleRanges are the ranges of characters checked by default,
+// covering the characters handled by each option.
+// The other ranges are checked only with the exhaustive build tag.
+var segmentSampleRanges = [][2]rune{
+	{0x002D, 0x0031},
+	{0x0061, 0x007A},
+	{0x2010, 0x2015},
+	{0x2460, 0x2460},
+	{0x3005, 0x3005},
+	{0x3031, 0x3035},
+	{0x3099, 0x30A2},
+	{0x30AB, 0x30AC},
+	{0x30CF, 0x30D1},
+	{0x30F7, 0x30FF},
+	{0x3131, 0x3131},
+	{0x314F, 0x314F},
+	{0x32D0, 0x32D0},
+	{0x3300, 0x3300},
+	{0xFF0D, 0xFF11},
+	{0xFF41, 0xFF41},
+	{0xFF5E, 0xFF5E},
+	{0xFF66, 0xFFA1},
+	{0xFFC2, 0xFFC2},
+	{0x1B132, 0x1B132},
+	{0x1B155, 0x1B155},
+}
+
+// testRanges returns ranges if the test is exhaustive,
+// and segmentSampleRanges otherwise.
+func testRanges(ranges [][2]rune) [][2]rune {
+	if exhaustiveTest {
+		return ranges
+	}
+	return segmentSampleRanges
+}
+
+func convertWithPipeline(s string, opts ConvertOptions) string {
+	return convertStream(inputString(s).stream(0, len(s)), opts).readAll(len(s))
+}
+
+// TestMayChange checks that mayChange is conservative,
+// i.e. it returns true for all the characters the pipeline changes.
+func TestMayChange(t *testing.T) {
+	for _, opts := range segmentTestOptions {
+		t.Run(opts.String(), func(t *testing.T) {
+			for _, r := range testRanges(segmentTestRanges) {
+				for ch := r[0]; ch <= r[1]; ch++ {
+					if 0xD800 <= ch && ch <= 0xDFFF {
+						continue
+					}
+					s := string(ch)
+					if convertWithPipeline(s, opts) != s && !mayChange(ch, opts.Normalize()) {
+						t.Errorf("mayChange(%U) = false, but it is changed", ch)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestSegmentBoundaries checks that converting two characters separately
+// gives the same result as converting them at once,
+// unless they are in the same segment.
+func TestSegmentBoundaries(t *testing.T) {
+	followers := []rune{'゙', '゚', '゛', '゜', 'ﾞ', 'ﾟ', 'a', 'n', 'y', 'h', '\'', '-', 'Ａ', '’', 'ゝ', 'ゞ', 'ヽ', '〱', '〲', '〳', '〵', '々', 'ー', 'ｰ', 'ⓐ', '1', '‘', 'ㅏ', 'ￂ', '‐', '－'}
+	for _, opts := range segmentTestOptions {
+		t.Run(opts.String(), func(t *testing.T) {
+			for _, r := range testRanges(segmentBoundaryTestRanges) {
+				for ch := r[0]; ch <= r[1]; ch++ {
+					if 0xD800 <= ch && ch <= 0xDFFF {
+						continue
+					}
+					if mayJoinNext(ch, opts.Normalize()) && !mayChange(ch, opts.Normalize()) {
+						t.Errorf("mayJoinNext(%U) = true, but mayChange(%U) = false", ch, ch)
+					}
+					for _, next := range followers {
+						if joins(ch, next, opts.Normalize()) {
+							if !mayJoinNext(ch, opts.Normalize()) {
+								t.Errorf("joins(%U, %U) = true, but mayJoinNext(%U) = false", ch, next, ch)
+							}
+							continue
+						}
+						joined := convertWithPipeline(string(ch)+string(next), opts)
+						separate := convertWithPipeline(string(ch), opts) + convertWithPipeline(string(next), opts)
+						if joined != separate {
+							t.Errorf("%U %U: %q != %q", ch, next, joined, separate)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNextSegmentMaxLen(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  string
+		opts   ConvertOptions
+		atEOF  bool
+		expect int
+	}{
+		{
+			name:   "long consonant run",
+			input:  strings.Repeat("k", maxSegmentLen+10) + "a",
+			opts:   RomajiToHiragana,
+			atEOF:  false,
+			expect: maxSegmentLen + 11,
+		},
+		{
+			name:   "cut at the limit",
+			input:  strings.Repeat("k", maxRomajiSegmentLen+10) + "a",
+			opts:   RomajiToHiragana,
+			atEOF:  false,
+			expect: maxRomajiSegmentLen,
+		},
+		{
+			name:   "not cut at EOF",
+			input:  strings.Repeat("k", maxRomajiSegmentLen+10) + "a",
+			opts:   RomajiToHiragana,
+			atEOF:  true,
+			expect: maxRomajiSegmentLen + 11,
+		},
+		{
+			name:   "ends at the limit",
+			input:  strings.Repeat("k", maxRomajiSegmentLen-1) + "ab",
+			opts:   RomajiToHiragana,
+			atEOF:  false,
+			expect: maxRomajiSegmentLen,
+		},
+		{
+			name:   "cut at the limit for other characters",
+			input:  strings.Repeat("カ", maxSegmentLen+10),
+			opts:   ExpandVerticalIterationMarks,
+			atEOF:  false,
+			expect: 3 * maxSegmentLen,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, ok := nextSegment(inputString(tc.input), 0, tc.atEOF, tc.opts)
+			if !ok {
+				t.Fatalf("unexpected incomplete segment")
+			}
+			if n != tc.expect {
+				t.Errorf("nextSegment() = %d, want %d", n, tc.expect)
+			}
+		})
+	}
+}
@@ -5,17 +5,34 @@ import (
 	"unicode/utf8"
 )
 
+// streamChunkSize is the maximum number of characters
+// a source stream decodes at once.
+const streamChunkSize = 64
+
+// stream is a pull-based stream of characters.
+//
+// buf[pos:] holds the characters that have been produced by next
+// but not consumed yet. Consumption only advances pos, and the consumed
+// part is reclaimed when the stream needs more characters,
+// so that each character is copied at most a constant number of times.
 type stream struct {
 	buf  []rune
+	pos  int
 	end  bool
 	next func(buf *[]rune)
+	// src is the stream next reads from, if any.
+	src *stream
+	// reset, if any, resets the state kept by next,
+	// such as the characters looked behind.
+	reset func()
 }
 
 func (s *stream) fill(demand int) {
 	if s.end {
 		return
 	}
-	for len(s.buf) < demand {
+	for len(s.buf)-s.pos < demand {
+		s.compact()
 		oldSize := len(s.buf)
 		s.next(&s.buf)
 		if len(s.buf) == oldSize {
@@ -25,34 +42,70 @@ func (s *stream) fill(demand int) {
 	}
 }
 
+// compact moves the unconsumed characters to the beginning of buf.
+func (s *stream) compact() {
+	if s.pos > 0 {
+		n := copy(s.buf, s.buf[s.pos:])
+		s.buf = s.buf[:n]
+		s.pos = 0
+	}
+}
+
 func (s *stream) consume(num int) {
-	newSize := len(s.buf) - num
-	for i := 0; i < newSize; i++ {
-		s.buf[i] = s.buf[i+num]
+	s.pos += num
+	if s.pos == len(s.buf) {
+		s.buf = s.buf[:0]
+		s.pos = 0
+	}
+}
+
+// restart resets the end-of-stream state of the stream
+// and its sources so that it pulls from the source again.
+func (s *stream) restart() {
+	for ; s != nil; s = s.src {
+		s.buf = s.buf[:0]
+		s.pos = 0
+		s.end = false
+		if s.reset != nil {
+			s.reset()
+		}
 	}
-	s.buf = s.buf[:newSize]
 }
 
 func (s *stream) readOne() (rune, bool) {
 	s.fill(1)
-	if len(s.buf) == 0 {
+	if len(s.buf) == s.pos {
 		return 0, false
 	}
-	ch := s.buf[0]
+	ch := s.buf[s.pos]
 	s.consume(1)
 	return ch, true
 }
 
 func (s *stream) peekOne() (rune, bool) {
 	s.fill(1)
-	if len(s.buf) == 0 {
+	if len(s.buf) == s.pos {
 		return 0, false
 	}
-	return s.buf[0], true
+	return s.buf[s.pos], true
+}
+
+// peek returns up to n characters without consuming them.
+// Fewer characters are returned if the stream ends earlier.
+// The returned slice is valid until the next operation on the stream.
+func (s *stream) peek(n int) []rune {
+	s.fill(n)
+	if len(s.buf)-s.pos < n {
+		n = len(s.buf) - s.pos
+	}
+	return s.buf[s.pos : s.pos+n]
 }
 
-func (s *stream) readAll() string {
+// readAll reads all the remaining characters as a string.
+// sizeHint is the expected length of the result in bytes.
+func (s *stream) readAll(sizeHint int) string {
 	builder := strings.Builder{}
+	builder.Grow(sizeHint)
 	if !s.end {
 		for {
 			s.readCurrentTo(&builder)
@@ -68,11 +121,51 @@ func (s *stream) readAll() string {
 	s.readCurrentTo(&builder)
 	return builder.String()
 }
+
 func (s *stream) readCurrentTo(builder *strings.Builder) {
-	for _, ch := range s.buf {
-		builder.WriteRune(ch)
+	for _, ch := range s.buf[s.pos:] {
+		if ch < utf8.RuneSelf {
+			builder.WriteByte(byte(ch))
+		} else {
+			builder.WriteRune(ch)
+		}
 	}
 	s.buf = s.buf[:0]
+	s.pos = 0
+}
+
+func (s *stream) appendAll(dst []byte) []byte {
+	if !s.end {
+		for {
+			dst = s.appendCurrent(dst)
+
+			oldSize := len(s.buf)
+			s.next(&s.buf)
+			if len(s.buf) == oldSize {
+				s.end = true
+				break
+			}
+		}
+	}
+	return s.appendCurrent(dst)
+}
+
+func (s *stream) appendCurrent(dst []byte) []byte {
+	for _, ch := range s.buf[s.pos:] {
+		dst = appendRune(dst, ch)
+	}
+	s.buf = s.buf[:0]
+	s.pos = 0
+	return dst
+}
+
+func appendRune(dst []byte, ch rune) []byte {
+	if ch < utf8.RuneSelf {
+		return append(dst, byte(ch))
+	}
+	var b [utf8.UTFMax]byte
+	n := utf8.EncodeRune(b[:], ch)
+	return append(dst, b[:n]...)
 }
 
 func newStream(next func(buf *[]rune)) *stream {
@@ -83,23 +176,20 @@ func newStream(next func(buf *[]rune)) *stream {
 	}
 }
 
-func stringStream(s string) *stream {
-	pos := 0
+// inputStream returns a stream of the characters in (*in)[*pos:*end].
+// The input and the range can be changed while the stream is not running,
+// in which case the stream must be restarted.
+func inputStream(in *input, pos, end *int) *stream {
 	return newStream(func(buf *[]rune) {
-		if pos < len(s) {
-			ch, size := utf8.DecodeRuneInString(s[pos:])
+		for i := 0; i < streamChunkSize && *pos < *end; i++ {
+			if c := in.at(*pos); c < utf8.RuneSelf {
+				*buf = append(*buf, rune(c))
+				*pos++
+				continue
+			}
+			ch, size := in.decodeRune(*pos)
 			*buf = append(*buf, ch)
-			pos += size
-		}
-	})
-}
-
-func mapStream(s *stream, f func(rune) rune) *stream {
-	return newStream(func(buf *[]rune) {
-		s.fill(1)
-		for _, ch := range s.buf {
-			*buf = append(*buf, f(ch))
+			*pos += size
 		}
-		s.consume(len(s.buf))
 	})
 }
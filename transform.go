@@ -0,0 +1,98 @@
+package kana
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// Transformer is a [transform.SpanningTransformer] that converts text
+// with the given options, in the same way as [Convert] does.
+//
+// It can be used with [transform.Chain], [transform.NewReader],
+// [transform.NewWriter] and similar functions.
+type Transformer struct {
+	opts ConvertOptions
+}
+
+var _ transform.SpanningTransformer = (*Transformer)(nil)
+
+// NewTransformer returns a [Transformer] that converts text with the given options.
+//
+// The transformer looks ahead a bounded number of characters to find
+// those converted together: 64 characters, or 1024 characters
+// in a run of romaji consonants with [RomajiToHiragana] or [RomajiToKatakana].
+// A longer run of such characters, which hardly appears in real text,
+// is split into chunks converted separately,
+// so the result may differ from that of [Convert] around the split.
+func NewTransformer(opts ConvertOptions) *Transformer {
+	return &Transformer{opts: opts.Normalize()}
+}
+
+// Reset implements [transform.Transformer]. It is a no-op
+// because the transformer does not keep any state between calls.
+func (t *Transformer) Reset() {}
+
+// Transform implements [transform.Transformer].
+//
+// It returns [transform.ErrShortSrc] if the input ends in the middle of
+// a UTF-8 sequence or with a character whose conversion depends on
+// the next character (e.g. a halfwidth katakana that may be followed by
+// a halfwidth voiced sound mark), unless atEOF is true.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	in := inputBytes(src)
+	var pl *pipeline
+	defer func() {
+		if pl != nil {
+			pl.release()
+		}
+	}()
+	for nSrc < len(src) {
+		// Copy the characters not affected by the conversion as is
+		if n := untouchedSpan(in, nSrc, t.opts); n > 0 {
+			if avail := len(dst) - nDst; n > avail {
+				// Copy as many whole characters as possible
+				for n = avail; n > 0 && !utf8.RuneStart(src[nSrc+n]); n-- {
+				}
+				nDst += copy(dst[nDst:], src[nSrc:nSrc+n])
+				return nDst, nSrc + n, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+n])
+			nSrc += n
+			continue
+		}
+
+		n, ok := nextSegment(in, nSrc, atEOF, t.opts)
+		if !ok {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		if pl == nil {
+			pl = getPipeline(pipelinePool(t.opts), in, t.opts)
+		}
+		// The capacity is limited so that the result is written in place
+		// unless it overflows.
+		out := pl.appendRange(dst[nDst:nDst:len(dst)], nSrc, nSrc+n)
+		if len(out) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += len(out)
+		nSrc += n
+	}
+	return nDst, nSrc, nil
+}
+
+// Span implements [transform.SpanningTransformer].
+//
+// It may stop at a character that may be affected by the conversion,
+// even if the character is actually kept as is.
+func (t *Transformer) Span(src []byte, atEOF bool) (n int, err error) {
+	in := inputBytes(src)
+	n = untouchedSpan(in, 0, t.opts)
+	if n == len(src) {
+		return n, nil
+	}
+	if _, ok := nextSegment(in, n, atEOF, t.opts); !ok {
+		return n, transform.ErrShortSrc
+	}
+	return n, transform.ErrEndOfSpan
+}
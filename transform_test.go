@@ -0,0 +1,317 @@
+package kana_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+	"golang.org/x/text/transform"
+)
+
+var transformTestcases = []struct {
+	name    string
+	input   string
+	options kana.ConvertOptions
+}{
+	{
+		name:    "ASCII",
+		input:   "Hello, world!",
+		options: kana.HalfwidthToWide | kana.FullwidthToNarrow,
+	},
+	{
+		name:    "Fullwidth forms",
+		input:   "ＡＢＣ　ＤＥＦ",
+		options: kana.FullwidthToNarrow,
+	},
+	{
+		name:    "Halfwidth voiced composites",
+		input:   "ｶﾞｷﾞｸﾞｹﾞｺﾞﾊﾟﾋﾟﾌﾟﾍﾟﾎﾟｳﾞﾜﾞｦﾞ",
+		options: kana.HalfwidthToWide,
+	},
+	{
+		name:    "Halfwidth voiced composites to hiragana",
+		input:   "ｶﾞｷﾞｸﾞｹﾞｺﾞﾊﾟﾋﾟﾌﾟﾍﾟﾎﾟｳﾞﾜﾞｦﾞ",
+		options: kana.HalfwidthToWide | kana.KatakanaToHiragana,
+	},
+	{
+		name:    "Halfwidth voiced composites with compat",
+		input:   "ｱﾞｶﾞﾞﾊﾟﾟﾜﾞｦﾞ",
+		options: kana.HalfwidthToWide | kana.CompatVoicedSoundMarks | kana.CompatVoicedKanaRestriction,
+	},
+	{
+		name:    "Trailing halfwidth kana",
+		input:   "ﾃｽﾄﾊ",
+		options: kana.HalfwidthToWide,
+	},
+	{
+		name:    "Invalid UTF-8",
+		input:   "ｶ\xE3\x82ﾞ\xFF",
+		options: kana.HalfwidthToWide,
+	},
+	{
+		name:    "Romaji",
+		input:   "kyouto shinnjuku ra-men kan'i hon",
+		options: kana.RomajiToKatakana,
+	},
+	{
+		name:    "Iteration marks",
+		input:   "いすゞ いろ〱 時々",
+		options: kana.ExpandIterationMarks | kana.ExpandVerticalIterationMarks | kana.ExpandKanjiIterationMarks,
+	},
+	{
+		name:    "Mixed",
+		input:   "ﾊﾟｿｺﾝでＡＢＣ－ひらがな",
+		options: kana.HalfwidthToWide | kana.FullwidthToNarrow | kana.HiraganaToKatakana | kana.CompatMinus,
+	},
+	{
+		name:    "Halfwidth sound marks back to halfwidth",
+		input:   "ｶﾟアﾞｰﾟﾝﾞaﾞﾞ",
+		options: kana.HalfwidthToWide | kana.WideKatakanaToHalfwidth,
+	},
+	{
+		name:    "Spacing sound marks to halfwidth",
+		input:   "ﾊ゛ア゛か゛あ゜゜",
+		options: kana.WideKatakanaToHalfwidth | kana.ComposeVoicedSoundMarks,
+	},
+	{
+		name:    "Prolonged sound marks from romaji",
+		input:   "a-ka--ｶ-",
+		options: kana.RomajiToHiragana | kana.ExpandProlongedSoundMark,
+	},
+	{
+		name:    "Prolonged sound marks from dashes",
+		input:   "ハ‐ﾜ-ハ－",
+		options: kana.NormalizeDashes | kana.HalfwidthToWide | kana.ExpandProlongedSoundMark,
+	},
+	{
+		name:    "Prolonged sound marks from dashes without width conversion",
+		input:   "ハ‐ハ－ひ―",
+		options: kana.NormalizeDashes | kana.ExpandProlongedSoundMark,
+	},
+	{
+		name:    "Prolonged sound marks from fullwidth hyphen-minus",
+		input:   "ハ－ﾜ-",
+		options: kana.FullwidthPunctuationToNarrow | kana.HalfwidthToWide | kana.RomajiToKatakana | kana.ExpandProlongedSoundMark,
+	},
+}
+
+func TestTransformer(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := kana.Convert(tc.input, tc.options)
+
+			actual, _, err := transform.String(kana.NewTransformer(tc.options), tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransformerOneByteReader(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := kana.Convert(tc.input, tc.options)
+
+			r := transform.NewReader(iotest.OneByteReader(strings.NewReader(tc.input)), kana.NewTransformer(tc.options))
+			actual, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, string(actual)); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransformerShortDst(t *testing.T) {
+	for _, tc := range transformTestcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := kana.Convert(tc.input, tc.options)
+
+			tr := kana.NewTransformer(tc.options)
+			src := []byte(tc.input)
+			// Large enough for the longest segment in the testcases
+			dst := make([]byte, 16)
+			var actual []byte
+			for {
+				nDst, nSrc, err := tr.Transform(dst, src, true)
+				actual = append(actual, dst[:nDst]...)
+				src = src[nSrc:]
+				if err == nil {
+					break
+				}
+				if err != transform.ErrShortDst {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if nSrc == 0 {
+					t.Fatalf("no progress")
+				}
+			}
+			if diff := cmp.Diff(expect, string(actual)); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransformerShortSrc(t *testing.T) {
+	testcases := []struct {
+		name       string
+		input      string
+		options    kana.ConvertOptions
+		expectDst  string
+		expectNSrc int
+	}{
+		{
+			name:       "Trailing halfwidth kana",
+			input:      "ｱｶ",
+			options:    kana.HalfwidthToWide,
+			expectDst:  "ア",
+			expectNSrc: len("ｱ"),
+		},
+		{
+			name:       "Trailing halfwidth kana without voiced form",
+			input:      "ｱｲ",
+			options:    kana.HalfwidthToWide,
+			expectDst:  "アイ",
+			expectNSrc: len("ｱｲ"),
+		},
+		{
+			name:       "Trailing halfwidth kana without HalfwidthToWide",
+			input:      "ｱｶ",
+			options:    kana.KatakanaToHiragana,
+			expectDst:  "ｱｶ",
+			expectNSrc: len("ｱｶ"),
+		},
+		{
+			name:       "Incomplete UTF-8",
+			input:      "ア\xE3\x82",
+			options:    kana.KatakanaToHiragana,
+			expectDst:  "あ",
+			expectNSrc: len("ア"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dst := make([]byte, 64)
+			nDst, nSrc, err := kana.NewTransformer(tc.options).Transform(dst, []byte(tc.input), false)
+			if nSrc < len(tc.input) && err != transform.ErrShortSrc {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectDst, string(dst[:nDst])); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+			if nSrc != tc.expectNSrc {
+				t.Errorf("unexpected nSrc: want %d, got %d", tc.expectNSrc, nSrc)
+			}
+		})
+	}
+}
+
+func TestTransformerSpan(t *testing.T) {
+	testcases := []struct {
+		name      string
+		input     string
+		options   kana.ConvertOptions
+		atEOF     bool
+		expectN   int
+		expectErr error
+	}{
+		{
+			name:      "All identical",
+			input:     "ABCアイウ",
+			options:   kana.FullwidthToNarrow | kana.HiraganaToKatakana,
+			atEOF:     true,
+			expectN:   len("ABCアイウ"),
+			expectErr: nil,
+		},
+		{
+			name:      "Changed in the middle",
+			input:     "ABCＤEF",
+			options:   kana.FullwidthToNarrow,
+			atEOF:     true,
+			expectN:   len("ABC"),
+			expectErr: transform.ErrEndOfSpan,
+		},
+		{
+			name:      "Trailing halfwidth kana",
+			input:     "ABCｶ",
+			options:   kana.HalfwidthToWide,
+			atEOF:     false,
+			expectN:   len("ABC"),
+			expectErr: transform.ErrShortSrc,
+		},
+		{
+			name:      "Incomplete UTF-8",
+			input:     "ABC\xE3\x82",
+			options:   kana.FullwidthToNarrow,
+			atEOF:     false,
+			expectN:   len("ABC"),
+			expectErr: transform.ErrShortSrc,
+		},
+		{
+			name:      "Invalid UTF-8",
+			input:     "ABC\xFF",
+			options:   0,
+			atEOF:     true,
+			expectN:   len("ABC"),
+			expectErr: transform.ErrEndOfSpan,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, err := kana.NewTransformer(tc.options).Span([]byte(tc.input), tc.atEOF)
+			if n != tc.expectN {
+				t.Errorf("unexpected n: want %d, got %d", tc.expectN, n)
+			}
+			if err != tc.expectErr {
+				t.Errorf("unexpected error: want %v, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestTransformerShortDstUntouched(t *testing.T) {
+	// Characters not affected by the conversion must not be split
+	dst := make([]byte, 4)
+	nDst, nSrc, err := kana.NewTransformer(kana.FullwidthToNarrow).Transform(dst, []byte("アイウ"), true)
+	if nDst != len("ア") || nSrc != len("ア") {
+		t.Errorf("unexpected progress: want (%d, %d), got (%d, %d)", len("ア"), len("ア"), nDst, nSrc)
+	}
+	if err != transform.ErrShortDst {
+		t.Errorf("unexpected error: want %v, got %v", transform.ErrShortDst, err)
+	}
+}
+
+func TestTransformerAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not counted with the race detector")
+	}
+	tr := kana.NewTransformer(kana.HalfwidthToWide | kana.FullwidthToNarrow)
+	src := []byte("ABCｶﾞｷﾞｸﾞ ＡＢＣ")
+	dst := make([]byte, 4*len(src))
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, _, err := tr.Transform(dst, src, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations in Transform: %v", allocs)
+	}
+	allocs = testing.AllocsPerRun(100, func() {
+		if _, err := tr.Span(src, true); err != transform.ErrEndOfSpan {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations in Span: %v", allocs)
+	}
+}
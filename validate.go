@@ -0,0 +1,237 @@
+package kana
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Validator validates strings consisting of kana,
+// such as the reading (furigana) of a name.
+//
+// For example, the following validator accepts fullwidth katakana,
+// U+3000 IDEOGRAPHIC SPACE, and U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー):
+//
+//	v := kana.Validator{Katakana: true, Allow: kana.AllowSpaces | kana.AllowProlongedSoundMark}
+//
+// U+3099 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK and
+// U+309A COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK following kana
+// are always accepted, so that the result does not depend on the normalization form.
+type Validator struct {
+	// Hiragana accepts hiragana (see [IsHiragana]).
+	Hiragana bool
+	// Katakana accepts katakana (see [IsKatakana]).
+	Katakana bool
+	// Halfwidth accepts the halfwidth forms of the accepted characters:
+	// halfwidth katakana followed by U+FF9E HALFWIDTH KATAKANA VOICED SOUND MARK (ﾞ)
+	// or U+FF9F HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK (ﾟ) if Katakana is true,
+	// and U+0020 SPACE, U+FF70 HALFWIDTH KATAKANA-HIRAGANA PROLONGED SOUND MARK (ｰ),
+	// and U+FF65 HALFWIDTH KATAKANA MIDDLE DOT (･) if allowed by Allow.
+	//
+	// Unlike [AllHiragana] and [AllKatakana], U+0020 SPACE is rejected
+	// as a halfwidth character unless Halfwidth is true.
+	Halfwidth bool
+	// Allow accepts the characters other than kana.
+	Allow KanaAllowance
+}
+
+// ValidationReason describes why a character is rejected by [Validator].
+type ValidationReason int
+
+const (
+	// ReasonNotAllowed means that the character is neither kana
+	// nor allowed by [Validator.Allow].
+	ReasonNotAllowed ValidationReason = iota + 1
+	// ReasonHiragana means that the character is hiragana,
+	// which is not accepted.
+	ReasonHiragana
+	// ReasonKatakana means that the character is katakana,
+	// which is not accepted.
+	ReasonKatakana
+	// ReasonHalfwidth means that the character is a halfwidth form,
+	// which is not accepted.
+	ReasonHalfwidth
+	// ReasonSoundMark means that the character is a sound mark
+	// not following kana, or a spacing sound mark such as
+	// U+309B KATAKANA-HIRAGANA VOICED SOUND MARK (゛).
+	ReasonSoundMark
+	// ReasonInvalidUTF8 means that the input contains
+	// an invalid UTF-8 sequence.
+	ReasonInvalidUTF8
+)
+
+func (r ValidationReason) String() string {
+	switch r {
+	case ReasonNotAllowed:
+		return "character not allowed"
+	case ReasonHiragana:
+		return "hiragana not allowed"
+	case ReasonKatakana:
+		return "katakana not allowed"
+	case ReasonHalfwidth:
+		return "halfwidth form not allowed"
+	case ReasonSoundMark:
+		return "misplaced sound mark"
+	case ReasonInvalidUTF8:
+		return "invalid UTF-8"
+	}
+	return fmt.Sprintf("ValidationReason(%d)", int(r))
+}
+
+// ValidationError reports a character rejected by [Validator].
+type ValidationError struct {
+	// Offset is the byte offset of the character in the input.
+	Offset int
+	// Char is the character rejected.
+	// It is [utf8.RuneError] for an invalid UTF-8 sequence.
+	Char rune
+	// Reason is why the character is rejected.
+	Reason ValidationReason
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("kana: %q (%U) at offset %d: %v", e.Char, e.Char, e.Offset, e.Reason)
+}
+
+// ValidationErrors is the list of the characters rejected by [Validator],
+// in the order of the offsets.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	return fmt.Sprintf("%v (and %d more)", e[0], len(e)-1)
+}
+
+// Validate checks that s consists only of the characters accepted by v.
+// It returns nil if s is valid, and [ValidationErrors] otherwise.
+func (v Validator) Validate(s string) error {
+	if errs, _ := v.validate(s); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// FixOptions returns the minimal options for [Convert]
+// to fix the characters in s rejected by v.
+// It returns 0 if s is valid or no rejected character can be fixed
+// by the conversion.
+//
+// The following options may be returned:
+//
+//   - [HiraganaToKatakana] for hiragana if only katakana are accepted
+//   - [KatakanaToHiragana] for katakana if only hiragana are accepted
+//   - [HalfwidthToWide] for halfwidth forms unless [Validator.Halfwidth] is true
+//   - [NarrowSpaceToFullwidth] for U+0020 SPACE unless [Validator.Halfwidth] is true
+//   - [ComposeVoicedSoundMarks] for spacing sound marks following kana
+func (v Validator) FixOptions(s string) ConvertOptions {
+	_, opts := v.validate(s)
+	return opts
+}
+
+// Fix converts s with [Validator.FixOptions] so that v accepts it.
+//
+// If the result still contains rejected characters, such as kanji,
+// it returns the result along with [ValidationErrors],
+// whose offsets are those in the result.
+func (v Validator) Fix(s string) (string, error) {
+	fixed := Convert(s, v.FixOptions(s))
+	return fixed, v.Validate(fixed)
+}
+
+// validate returns the characters in s rejected by v
+// and the options to fix them.
+func (v Validator) validate(s string) (ValidationErrors, ConvertOptions) {
+	var errs ValidationErrors
+	var opts ConvertOptions
+	afterKana := false
+	for i, r := range s {
+		var reason ValidationReason
+		var fix ConvertOptions
+		isKana := true
+		switch {
+		case IsHiragana(r):
+			if !v.Hiragana {
+				reason = ReasonHiragana
+				if v.Katakana {
+					fix = HiraganaToKatakana
+				}
+			}
+		case IsKatakana(r):
+			if !v.Katakana {
+				reason = ReasonKatakana
+				if v.Hiragana {
+					fix = KatakanaToHiragana
+				}
+			}
+		case IsHalfwidthKatakana(r):
+			if !v.Katakana {
+				reason = ReasonKatakana
+				if v.Hiragana {
+					fix = HalfwidthToWide | KatakanaToHiragana
+				}
+			} else if !v.Halfwidth {
+				reason = ReasonHalfwidth
+				fix = HalfwidthToWide
+			}
+		case r == '\u3099' || r == '\u309A':
+			isKana = afterKana
+			if !afterKana {
+				reason = ReasonSoundMark
+			}
+		case r == '\uFF9E' || r == '\uFF9F':
+			isKana = afterKana
+			if !afterKana {
+				reason = ReasonSoundMark
+			} else if !v.Halfwidth {
+				// Converted to the combining sound marks
+				reason = ReasonHalfwidth
+				fix = HalfwidthToWide
+			}
+		case r == '\u309B' || r == '\u309C':
+			isKana = afterKana
+			reason = ReasonSoundMark
+			if afterKana {
+				// Composed with the preceding kana, or
+				// converted to the combining sound marks
+				fix = ComposeVoicedSoundMarks
+			}
+		default:
+			isKana = false
+			reason, fix = v.validateOther(r, s[i:])
+		}
+		afterKana = isKana
+		if reason != 0 {
+			errs = append(errs, &ValidationError{Offset: i, Char: r, Reason: reason})
+			opts |= fix
+		}
+	}
+	if len(errs) == 0 {
+		return nil, 0
+	}
+	return errs, opts
+}
+
+// validateOther validates r other than kana and sound marks.
+// rest is the input starting at r.
+func (v Validator) validateOther(r rune, rest string) (ValidationReason, ConvertOptions) {
+	if r == utf8.RuneError {
+		if _, size := utf8.DecodeRuneInString(rest); size == 1 {
+			return ReasonInvalidUTF8, 0
+		}
+	}
+	var fix ConvertOptions
+	switch r {
+	case ' ':
+		fix = NarrowSpaceToFullwidth
+	case '\uFF65', '\uFF70':
+		fix = HalfwidthToWide
+	}
+	if !v.Allow.allows(r) && !(fix == HalfwidthToWide && v.Allow.allows(halfwidthMap[r])) {
+		return ReasonNotAllowed, 0
+	}
+	if fix != 0 && !v.Halfwidth {
+		return ReasonHalfwidth, fix
+	}
+	return 0, 0
+}
@@ -0,0 +1,246 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+var readingValidator = kana.Validator{
+	Katakana: true,
+	Allow:    kana.AllowSpaces | kana.AllowProlongedSoundMark,
+}
+
+func TestValidatorValidate(t *testing.T) {
+	testcases := []struct {
+		name      string
+		validator kana.Validator
+		input     string
+		expect    kana.ValidationErrors
+	}{
+		{
+			name:      "valid",
+			validator: readingValidator,
+			input:     "ヤマダ　タロー",
+			expect:    nil,
+		},
+		{
+			name:      "empty",
+			validator: readingValidator,
+			input:     "",
+			expect:    nil,
+		},
+		{
+			name:      "decomposed",
+			validator: readingValidator,
+			input:     "ヤマタ\u3099",
+			expect:    nil,
+		},
+		{
+			name:      "hiragana",
+			validator: readingValidator,
+			input:     "ヤマだ",
+			expect: kana.ValidationErrors{
+				{Offset: 6, Char: 'だ', Reason: kana.ReasonHiragana},
+			},
+		},
+		{
+			name:      "halfwidth",
+			validator: readingValidator,
+			input:     "ﾔﾏﾀﾞ ﾀﾛｰ",
+			expect: kana.ValidationErrors{
+				{Offset: 0, Char: 'ﾔ', Reason: kana.ReasonHalfwidth},
+				{Offset: 3, Char: 'ﾏ', Reason: kana.ReasonHalfwidth},
+				{Offset: 6, Char: 'ﾀ', Reason: kana.ReasonHalfwidth},
+				{Offset: 9, Char: 'ﾞ', Reason: kana.ReasonHalfwidth},
+				{Offset: 12, Char: ' ', Reason: kana.ReasonHalfwidth},
+				{Offset: 13, Char: 'ﾀ', Reason: kana.ReasonHalfwidth},
+				{Offset: 16, Char: 'ﾛ', Reason: kana.ReasonHalfwidth},
+				{Offset: 19, Char: 'ｰ', Reason: kana.ReasonHalfwidth},
+			},
+		},
+		{
+			name:      "halfwidth allowed",
+			validator: kana.Validator{Katakana: true, Halfwidth: true, Allow: kana.AllowSpaces | kana.AllowProlongedSoundMark},
+			input:     "ﾔﾏﾀﾞ ﾀﾛｰ ヤマダ　タロー",
+			expect:    nil,
+		},
+		{
+			name:      "not allowed",
+			validator: readingValidator,
+			input:     "ジョン・スミス 山田",
+			expect: kana.ValidationErrors{
+				{Offset: 9, Char: '・', Reason: kana.ReasonNotAllowed},
+				{Offset: 21, Char: ' ', Reason: kana.ReasonHalfwidth},
+				{Offset: 22, Char: '山', Reason: kana.ReasonNotAllowed},
+				{Offset: 25, Char: '田', Reason: kana.ReasonNotAllowed},
+			},
+		},
+		{
+			name:      "spaces not allowed",
+			validator: kana.Validator{Katakana: true, Halfwidth: true},
+			input:     "ヤマダ タロウ",
+			expect: kana.ValidationErrors{
+				{Offset: 9, Char: ' ', Reason: kana.ReasonNotAllowed},
+			},
+		},
+		{
+			name:      "sound marks",
+			validator: readingValidator,
+			input:     "\u3099カ゛",
+			expect: kana.ValidationErrors{
+				{Offset: 0, Char: '\u3099', Reason: kana.ReasonSoundMark},
+				{Offset: 6, Char: '゛', Reason: kana.ReasonSoundMark},
+			},
+		},
+		{
+			name:      "katakana in hiragana",
+			validator: kana.Validator{Hiragana: true},
+			input:     "やまダ",
+			expect: kana.ValidationErrors{
+				{Offset: 6, Char: 'ダ', Reason: kana.ReasonKatakana},
+			},
+		},
+		{
+			name:      "both scripts",
+			validator: kana.Validator{Hiragana: true, Katakana: true},
+			input:     "やまダ",
+			expect:    nil,
+		},
+		{
+			name:      "invalid UTF-8",
+			validator: readingValidator,
+			input:     "ヤ\xFF",
+			expect: kana.ValidationErrors{
+				{Offset: 3, Char: '�', Reason: kana.ReasonInvalidUTF8},
+			},
+		},
+		{
+			name:      "replacement character",
+			validator: readingValidator,
+			input:     "ヤ�",
+			expect: kana.ValidationErrors{
+				{Offset: 3, Char: '�', Reason: kana.ReasonNotAllowed},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.validator.Validate(tc.input)
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			errs, ok := err.(kana.ValidationErrors)
+			if !ok {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expect, errs); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatorFix(t *testing.T) {
+	testcases := []struct {
+		name        string
+		validator   kana.Validator
+		input       string
+		expectOpts  kana.ConvertOptions
+		expect      string
+		expectError bool
+	}{
+		{
+			name:       "valid",
+			validator:  readingValidator,
+			input:      "ヤマダ　タロー",
+			expectOpts: 0,
+			expect:     "ヤマダ　タロー",
+		},
+		{
+			name:       "hiragana",
+			validator:  readingValidator,
+			input:      "やまだ　たろー",
+			expectOpts: kana.HiraganaToKatakana,
+			expect:     "ヤマダ　タロー",
+		},
+		{
+			name:       "halfwidth",
+			validator:  readingValidator,
+			input:      "ﾔﾏﾀﾞ ﾀﾛｰ",
+			expectOpts: kana.HalfwidthToWide | kana.NarrowSpaceToFullwidth,
+			expect:     "ヤマダ　タロー",
+		},
+		{
+			name:       "halfwidth and hiragana",
+			validator:  readingValidator,
+			input:      "ﾔﾏﾀﾞ　たろう",
+			expectOpts: kana.HalfwidthToWide | kana.HiraganaToKatakana,
+			expect:     "ヤマダ　タロウ",
+		},
+		{
+			name:       "halfwidth to hiragana",
+			validator:  kana.Validator{Hiragana: true},
+			input:      "ﾔﾏﾀﾞ",
+			expectOpts: kana.HalfwidthToWide | kana.KatakanaToHiragana,
+			expect:     "やまだ",
+		},
+		{
+			name:       "spacing sound marks",
+			validator:  readingValidator,
+			input:      "カ゛ア゛",
+			expectOpts: kana.ComposeVoicedSoundMarks,
+			expect:     "ガア\u3099",
+		},
+		{
+			name:        "not fixable",
+			validator:   readingValidator,
+			input:       "やまだ太郎",
+			expectOpts:  kana.HiraganaToKatakana,
+			expect:      "ヤマダ太郎",
+			expectError: true,
+		},
+		{
+			name:        "digraph not converted",
+			validator:   kana.Validator{Katakana: true},
+			input:       "ゟ",
+			expectOpts:  0,
+			expect:      "ゟ",
+			expectError: true,
+		},
+		{
+			name:        "hiragana not fixable without katakana",
+			validator:   kana.Validator{Allow: kana.AllowSpaces},
+			input:       "やまだ",
+			expectOpts:  0,
+			expect:      "やまだ",
+			expectError: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expectOpts, tc.validator.FixOptions(tc.input)); diff != "" {
+				t.Errorf("unexpected diff in FixOptions (-want +got):\n%s", diff)
+			}
+			actual, err := tc.validator.Fix(tc.input)
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+			if (err != nil) != tc.expectError {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsError(t *testing.T) {
+	err := readingValidator.Validate("やa")
+	expect := "kana: 'や' (U+3084) at offset 0: hiragana not allowed (and 1 more)"
+	if diff := cmp.Diff(expect, err.Error()); diff != "" {
+		t.Errorf("unexpected diff (-want +got):\n%s", diff)
+	}
+}
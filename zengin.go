@@ -0,0 +1,121 @@
+package kana
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// zenginOptions converts the input as close as possible
+// to the Zengin character set before the validation.
+const zenginOptions = FullwidthToNarrow | HiraganaToKatakana | SmallKanaToLarge | NormalizeDashes | WideKatakanaToHalfwidth
+
+// ZenginError is returned by [ToZengin] when the input contains
+// a character that cannot be represented in the Zengin character set.
+type ZenginError struct {
+	// Offset is the byte offset of the character in the input.
+	Offset int
+	// Char is the character that cannot be represented.
+	// It is [utf8.RuneError] for an invalid UTF-8 sequence.
+	Char rune
+}
+
+func (e *ZenginError) Error() string {
+	return fmt.Sprintf("kana: %q (%U) at offset %d cannot be represented in Zengin", e.Char, e.Char, e.Offset)
+}
+
+// ToZengin converts a string to the character set used for
+// the Zengin (全銀) bank transfer system, such as account holder names.
+//
+// The Zengin character set consists of:
+//
+//   - U+FF71 HALFWIDTH KATAKANA LETTER A (ｱ) to U+FF9D HALFWIDTH KATAKANA LETTER N (ﾝ)
+//   - U+FF9E HALFWIDTH KATAKANA VOICED SOUND MARK (ﾞ) and U+FF9F HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK (ﾟ)
+//   - Uppercase Latin letters (A to Z) and digits (0 to 9)
+//   - Space and ( ) . - / \
+//
+// The input is converted as follows before the validation:
+//
+//   - Hiragana and fullwidth katakana are converted to halfwidth katakana,
+//     as in [HiraganaToKatakana] and [WideKatakanaToHalfwidth].
+//   - Small kana are converted to full-size ones, as in [SmallKanaToLarge].
+//   - Fullwidth forms are converted to narrow ones, as in [FullwidthToNarrow].
+//   - Lowercase Latin letters are converted to uppercase.
+//   - Dashes and U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK (ー)
+//     are converted to '-'.
+//
+// For example, がっこう is converted to ｶﾞﾂｺｳ.
+//
+// If the result contains a character not in the character set,
+// ToZengin returns a [*ZenginError] reporting the first such character
+// in the input.
+func ToZengin(s string) (string, error) {
+	opts := zenginOptions.Normalize()
+	in := inputString(s)
+	pl := newPipeline(in, opts)
+	builder := strings.Builder{}
+	builder.Grow(len(s))
+	var buf []byte
+	for p := 0; p < len(s); {
+		n, _ := nextSegment(in, p, true, opts)
+		buf = pl.appendRange(buf[:0], p, p+n)
+		for _, ch := range string(buf) {
+			ch, ok := zenginRune(ch)
+			if !ok {
+				return "", zenginError(pl, s, p, p+n)
+			}
+			builder.WriteRune(ch)
+		}
+		p += n
+	}
+	return builder.String(), nil
+}
+
+// zenginError locates the character that cannot be represented
+// in the segment s[b:e], which is known to contain one.
+// It is the last character of the shortest prefix of the segment
+// whose result cannot be represented.
+func zenginError(pl *pipeline, s string, b, e int) *ZenginError {
+	var buf []byte
+	for q := b; q < e; {
+		ch, size := utf8.DecodeRuneInString(s[q:])
+		buf = pl.appendRange(buf[:0], b, q+size)
+		if !isZengin(string(buf)) {
+			return &ZenginError{Offset: q, Char: ch}
+		}
+		q += size
+	}
+	// Not reached unless the segment converts differently as a whole,
+	// in which case the segment is reported as a whole.
+	ch, _ := utf8.DecodeRuneInString(s[b:])
+	return &ZenginError{Offset: b, Char: ch}
+}
+
+// isZengin reports whether all the converted characters in s
+// can be represented in the Zengin character set.
+func isZengin(s string) bool {
+	for _, ch := range s {
+		if _, ok := zenginRune(ch); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// zenginRune maps a converted character to the Zengin character set.
+// It reports false if ch cannot be represented.
+func zenginRune(ch rune) (rune, bool) {
+	switch {
+	case 'ｱ' <= ch && ch <= 'ﾟ', 'A' <= ch && ch <= 'Z', '0' <= ch && ch <= '9':
+		return ch, true
+	case 'a' <= ch && ch <= 'z':
+		return ch - 'a' + 'A', true
+	}
+	switch ch {
+	case 'ｰ':
+		return '-', true
+	case ' ', '(', ')', '.', '-', '/', '\\':
+		return ch, true
+	}
+	return 0, false
+}
@@ -0,0 +1,147 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wantedly/kana-go"
+)
+
+func TestToZengin(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{
+			name:   "Katakana",
+			input:  "ヤマダ タロウ",
+			expect: "ﾔﾏﾀﾞ ﾀﾛｳ",
+		},
+		{
+			name:   "Hiragana with small kana",
+			input:  "がっこう",
+			expect: "ｶﾞﾂｺｳ",
+		},
+		{
+			name:   "Halfwidth katakana",
+			input:  "ｷｬﾗﾒﾙ",
+			expect: "ｷﾔﾗﾒﾙ",
+		},
+		{
+			name:   "Decomposed",
+			input:  "カ\u3099ハ\u309A",
+			expect: "ｶﾞﾊﾟ",
+		},
+		{
+			name:   "Prolonged sound mark",
+			input:  "ラーメン ｾﾝﾀｰ",
+			expect: "ﾗ-ﾒﾝ ｾﾝﾀ-",
+		},
+		{
+			name:   "VU and VA",
+			input:  "ヴヷ",
+			expect: "ｳﾞﾜﾞ",
+		},
+		{
+			name:   "Latin letters and digits",
+			input:  "abc ＸＹＺ １２３",
+			expect: "ABC XYZ 123",
+		},
+		{
+			name:   "Symbols",
+			input:  "（カ）．－／＼　ー",
+			expect: "(ｶ).-/\\ -",
+		},
+		{
+			name:   "Empty",
+			input:  "",
+			expect: "",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := kana.ToZengin(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToZenginError(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  string
+		expect *kana.ZenginError
+	}{
+		{
+			name:   "Kanji",
+			input:  "ヤマダ太郎",
+			expect: &kana.ZenginError{Offset: 9, Char: '太'},
+		},
+		{
+			name:   "Katakana without halfwidth form",
+			input:  "ヰスキー",
+			expect: &kana.ZenginError{Offset: 0, Char: 'ヰ'},
+		},
+		{
+			name:   "Decomposed katakana without halfwidth form",
+			input:  "アヰ\u3099",
+			expect: &kana.ZenginError{Offset: 3, Char: 'ヰ'},
+		},
+		{
+			name:   "WO",
+			input:  "アヲ",
+			expect: &kana.ZenginError{Offset: 3, Char: 'ヲ'},
+		},
+		{
+			name:   "VO",
+			input:  "ヺ",
+			expect: &kana.ZenginError{Offset: 0, Char: 'ヺ'},
+		},
+		{
+			name:   "Punctuation",
+			input:  "ｶﾌﾞｼｷｶﾞｲｼｬ、",
+			expect: &kana.ZenginError{Offset: 30, Char: '、'},
+		},
+		{
+			name:   "ASCII symbol",
+			input:  "A&B",
+			expect: &kana.ZenginError{Offset: 1, Char: '&'},
+		},
+		{
+			name:   "Invalid UTF-8",
+			input:  "ｱ\xFF",
+			expect: &kana.ZenginError{Offset: 3, Char: '�'},
+		},
+		{
+			name:   "Sound mark after digit",
+			input:  "1\u3099",
+			expect: &kana.ZenginError{Offset: 1, Char: '\u3099'},
+		},
+		{
+			name:   "Sound mark after phone number",
+			input:  "03ー1234\u3099",
+			expect: &kana.ZenginError{Offset: 9, Char: '\u3099'},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := kana.ToZengin(tc.input)
+			if actual != "" {
+				t.Errorf("unexpected result: %q", actual)
+			}
+			zerr, ok := err.(*kana.ZenginError)
+			if !ok {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expect, zerr); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}